@@ -8,6 +8,29 @@ provider "inferadb" {
   # Can also be set via INFERADB_SESSION_TOKEN environment variable
   # Obtain this by logging in via the InferaDB CLI: inferadb login
   session_token = var.inferadb_session_token
+
+  # Alternatively, read endpoint and session token from a profile of the
+  # credentials file written by `inferadb login` (~/.inferadb/credentials).
+  # Can also be set via INFERADB_PROFILE / INFERADB_CREDENTIALS_FILE.
+  # profile = "staging"
+
+  # Retry transient API failures (connection errors, 429, 502, 503, 504)
+  # with jittered exponential backoff. Retry-After is honored.
+  max_retries    = 3
+  retry_max_wait = 30
+
+  # Client-side rate limiting. Concurrency is lowered automatically while
+  # the API responds with 429 Too Many Requests.
+  requests_per_second     = 20
+  max_concurrent_requests = 10
+
+  # Connection settings for private deployments
+  # transport {
+  #   ca_cert_file    = "/etc/ssl/internal-ca.pem"
+  #   proxy_url       = "http://proxy.internal:3128"
+  #   request_timeout = 120
+  #   extra_headers   = { "X-Tenant" = "platform" }
+  # }
 }
 
 variable "inferadb_session_token" {
@@ -15,3 +38,25 @@ variable "inferadb_session_token" {
   description = "InferaDB session token for authentication"
   sensitive   = true
 }
+
+# Service account authentication with an inferadb_client certificate, for CI.
+# The private key is the private_key_pem returned by inferadb_client_certificate.
+#
+# provider "inferadb" {
+#   auth {
+#     client_id        = "123456789"
+#     certificate_kid  = "kid-abc"
+#     private_key_file = "/secrets/inferadb-ci.pem"
+#   }
+# }
+
+# OIDC workload identity federation for CI runners. The ID token is read from
+# the named environment variable (or oidc_token_file) and exchanged for a
+# short-lived access token.
+#
+# provider "inferadb" {
+#   auth {
+#     type           = "oidc"
+#     oidc_token_env = "INFERADB_ID_TOKEN"
+#   }
+# }
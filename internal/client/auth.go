@@ -0,0 +1,77 @@
+// Copyright 2025 InferaDB
+// SPDX-License-Identifier: Apache-2.0
+
+package client
+
+import (
+	"context"
+	"net/http"
+)
+
+// Authenticator adds credentials to outgoing API requests. It is called for
+// every attempt of every request, so implementations must be safe for
+// concurrent use.
+type Authenticator interface {
+	Authenticate(ctx context.Context, req *http.Request) error
+}
+
+// Refresher is implemented by authenticators whose credentials can be
+// renewed. When the API rejects a request with 401 Unauthorized, the client
+// calls Refresh with the rejected request and replays the request once.
+type Refresher interface {
+	Refresh(ctx context.Context, rejected *http.Request) error
+}
+
+// SessionCookieAuth authenticates with an infera_session cookie, as issued by
+// the dashboard and `inferadb login`.
+type SessionCookieAuth struct {
+	Token string
+}
+
+// Authenticate implements Authenticator.
+func (a SessionCookieAuth) Authenticate(_ context.Context, req *http.Request) error {
+	req.AddCookie(&http.Cookie{
+		Name:  "infera_session",
+		Value: a.Token,
+	})
+	return nil
+}
+
+// BearerTokenAuth authenticates with an `Authorization: Bearer` header.
+type BearerTokenAuth struct {
+	Token string
+}
+
+// Authenticate implements Authenticator.
+func (a BearerTokenAuth) Authenticate(_ context.Context, req *http.Request) error {
+	req.Header.Set("Authorization", "Bearer "+a.Token)
+	return nil
+}
+
+// HeaderAuth authenticates with an arbitrary header, such as the API key
+// header expected by a gateway in front of the Control API.
+type HeaderAuth struct {
+	Name  string
+	Value string
+}
+
+// Authenticate implements Authenticator.
+func (a HeaderAuth) Authenticate(_ context.Context, req *http.Request) error {
+	req.Header.Set(a.Name, a.Value)
+	return nil
+}
+
+// authenticationError reports that an Authenticator could not add credentials
+// to a request. Authenticators retry their own exchanges, so the request
+// itself is not retried.
+type authenticationError struct {
+	err error
+}
+
+func (e *authenticationError) Error() string {
+	return "failed to authenticate request: " + e.err.Error()
+}
+
+func (e *authenticationError) Unwrap() error {
+	return e.err
+}
@@ -0,0 +1,137 @@
+// Copyright 2025 InferaDB
+// SPDX-License-Identifier: Apache-2.0
+
+package client
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+)
+
+func TestAuthenticators(t *testing.T) {
+	tests := []struct {
+		name  string
+		cfg   Config
+		check func(*http.Request) bool
+	}{
+		{
+			name: "session token defaults to cookie",
+			cfg:  Config{SessionToken: "s3cret"},
+			check: func(r *http.Request) bool {
+				cookie, err := r.Cookie("infera_session")
+				return err == nil && cookie.Value == "s3cret"
+			},
+		},
+		{
+			name: "bearer",
+			cfg:  Config{SessionToken: "ignored", Authenticator: BearerTokenAuth{Token: "t0ken"}},
+			check: func(r *http.Request) bool {
+				_, err := r.Cookie("infera_session")
+				return r.Header.Get("Authorization") == "Bearer t0ken" && err != nil
+			},
+		},
+		{
+			name: "custom header",
+			cfg:  Config{Authenticator: HeaderAuth{Name: "X-Api-Key", Value: "k3y"}},
+			check: func(r *http.Request) bool {
+				return r.Header.Get("X-Api-Key") == "k3y"
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if !tt.check(r) {
+					w.WriteHeader(http.StatusUnauthorized)
+					return
+				}
+				_, _ = w.Write([]byte(`{"organization":{"id":1}}`))
+			}))
+			defer srv.Close()
+
+			tt.cfg.Endpoint = srv.URL
+			if _, err := New(tt.cfg).GetOrganization(context.Background(), "1"); err != nil {
+				t.Fatalf("GetOrganization: %v", err)
+			}
+		})
+	}
+}
+
+// rotatingAuth sends token and switches to fresh when refreshed.
+type rotatingAuth struct {
+	token     atomic.Value
+	fresh     string
+	refreshes atomic.Int32
+}
+
+func (a *rotatingAuth) Authenticate(_ context.Context, req *http.Request) error {
+	req.Header.Set("Authorization", "Bearer "+a.token.Load().(string))
+	return nil
+}
+
+func (a *rotatingAuth) Refresh(context.Context, *http.Request) error {
+	a.refreshes.Add(1)
+	a.token.Store(a.fresh)
+	return nil
+}
+
+func TestUnauthorizedRefreshesAndReplays(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "Bearer fresh" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		_, _ = w.Write([]byte(`{"organization":{"id":1}}`))
+	}))
+	defer srv.Close()
+
+	auth := &rotatingAuth{fresh: "fresh"}
+	auth.token.Store("expired")
+	c := New(Config{Endpoint: srv.URL, Authenticator: auth})
+	if _, err := c.GetOrganization(context.Background(), "1"); err != nil {
+		t.Fatalf("GetOrganization: %v", err)
+	}
+	if got := auth.refreshes.Load(); got != 1 {
+		t.Errorf("refreshes = %d, want 1", got)
+	}
+}
+
+func TestUnauthorizedFailsFastWithHint(t *testing.T) {
+	var requests atomic.Int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests.Add(1)
+		w.WriteHeader(http.StatusUnauthorized)
+		_, _ = w.Write([]byte(`{"error":"session expired"}`))
+	}))
+	defer srv.Close()
+
+	c := New(Config{Endpoint: srv.URL, SessionToken: "expired", ReauthHint: "Run `inferadb login`"})
+
+	_, err := c.GetOrganization(context.Background(), "1")
+	var authErr *AuthError
+	if !errors.As(err, &authErr) || authErr.Repeated {
+		t.Fatalf("first error = %v, want non-repeated *AuthError", err)
+	}
+	if !IsUnauthorized(err) || !strings.Contains(err.Error(), "inferadb login") {
+		t.Errorf("first error = %q, want unauthorized error with hint", err)
+	}
+
+	first := authErr
+
+	_, err = c.GetVault(context.Background(), "1", "2")
+	if !errors.As(err, &authErr) || !authErr.Repeated || strings.Contains(err.Error(), "inferadb login") {
+		t.Errorf("second error = %v, want repeated *AuthError without hint", err)
+	}
+	if authErr.Err != first.Err || authErr.Hint != first.Hint {
+		t.Errorf("second error = %+v, want a copy of the first failure %+v", authErr, first)
+	}
+	if got := requests.Load(); got != 1 {
+		t.Errorf("requests sent = %d, want 1", got)
+	}
+}
@@ -0,0 +1,137 @@
+// Copyright 2025 InferaDB
+// SPDX-License-Identifier: Apache-2.0
+
+package client
+
+import (
+	"context"
+	"strings"
+	"sync"
+	"time"
+)
+
+// DefaultCacheTTL is how long GET responses are reused. It is long enough
+// for the resources of one plan to share their parents' reads and short
+// enough that a following run sees fresh data.
+const DefaultCacheTTL = 30 * time.Second
+
+// readCache caches the results of successful reads by path and coalesces
+// identical in-flight reads into a single call. The client keeps one for GET
+// responses and one for complete lists served by the Lookup methods.
+type readCache struct {
+	ttl time.Duration
+
+	mu      sync.Mutex
+	entries map[string]cacheEntry
+	flights map[string]*flight
+
+	// generation changes on every invalidation, so a GET that was in flight
+	// during a write does not store its possibly stale response.
+	generation uint64
+}
+
+type cacheEntry struct {
+	value   interface{}
+	expires time.Time
+}
+
+// flight is a GET in progress that later callers for the same path wait on.
+type flight struct {
+	done  chan struct{}
+	value interface{}
+	err   error
+
+	// cancelled is set when the context of the caller that made the read
+	// ended, so its error says nothing about the waiters' reads.
+	cancelled bool
+}
+
+// newReadCache returns a cache keeping responses for ttl, or nil when
+// caching is disabled.
+func newReadCache(ttl time.Duration) *readCache {
+	switch {
+	case ttl == 0:
+		ttl = DefaultCacheTTL
+	case ttl < 0:
+		return nil
+	}
+	return &readCache{
+		ttl:     ttl,
+		entries: make(map[string]cacheEntry),
+		flights: make(map[string]*flight),
+	}
+}
+
+// get returns the cached value for path, waits for an identical read in
+// flight, or calls fetch. Errors are shared with waiting callers but not
+// cached, except when the read failed because its caller's context ended:
+// waiters then read again with their own fetch.
+func (rc *readCache) get(ctx context.Context, path string, fetch func() (interface{}, error)) (interface{}, error) {
+	rc.mu.Lock()
+	for {
+		if entry, ok := rc.entries[path]; ok {
+			if time.Now().Before(entry.expires) {
+				rc.mu.Unlock()
+				return entry.value, nil
+			}
+			delete(rc.entries, path)
+		}
+		f, ok := rc.flights[path]
+		if !ok {
+			break
+		}
+		rc.mu.Unlock()
+		select {
+		case <-f.done:
+			if !f.cancelled {
+				return f.value, f.err
+			}
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		}
+		rc.mu.Lock()
+	}
+
+	f := &flight{done: make(chan struct{})}
+	rc.flights[path] = f
+	generation := rc.generation
+	rc.mu.Unlock()
+
+	f.value, f.err = fetch()
+	f.cancelled = f.err != nil && ctx.Err() != nil
+
+	rc.mu.Lock()
+	delete(rc.flights, path)
+	if f.err == nil && rc.generation == generation {
+		rc.entries[path] = cacheEntry{value: f.value, expires: time.Now().Add(rc.ttl)}
+	}
+	rc.mu.Unlock()
+	close(f.done)
+
+	return f.value, f.err
+}
+
+// invalidate drops the cached results a write to path may have changed:
+// those for path itself, for its descendants, and for its ancestors, such
+// as the collection listing a created or deleted object.
+func (rc *readCache) invalidate(path string) {
+	written := stripQuery(path)
+
+	rc.mu.Lock()
+	defer rc.mu.Unlock()
+
+	rc.generation++
+	for key := range rc.entries {
+		cached := stripQuery(key)
+		if cached == written || strings.HasPrefix(cached, written+"/") || strings.HasPrefix(written, cached+"/") {
+			delete(rc.entries, key)
+		}
+	}
+}
+
+func stripQuery(path string) string {
+	if i := strings.IndexByte(path, '?'); i >= 0 {
+		return path[:i]
+	}
+	return path
+}
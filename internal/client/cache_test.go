@@ -0,0 +1,174 @@
+// Copyright 2025 InferaDB
+// SPDX-License-Identifier: Apache-2.0
+
+package client
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestReadCacheCoalescesConcurrentGets(t *testing.T) {
+	var calls atomic.Int32
+	release := make(chan struct{})
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls.Add(1)
+		<-release
+		_, _ = w.Write([]byte(`{"organization":{"id":1,"name":"acme"}}`))
+	}))
+	defer srv.Close()
+
+	c := New(Config{Endpoint: srv.URL})
+
+	var wg sync.WaitGroup
+	errs := make(chan error, 10)
+	for range 10 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			_, err := c.GetOrganization(context.Background(), "1")
+			errs <- err
+		}()
+	}
+	time.Sleep(50 * time.Millisecond)
+	close(release)
+	wg.Wait()
+	close(errs)
+
+	for err := range errs {
+		if err != nil {
+			t.Fatalf("GetOrganization: %v", err)
+		}
+	}
+	if got := calls.Load(); got != 1 {
+		t.Errorf("HTTP calls = %d, want 1", got)
+	}
+}
+
+// A waiter does not inherit the error of a read whose caller gave up; it
+// reads again with its own context.
+func TestReadCacheRefetchesAfterCancelledRead(t *testing.T) {
+	var calls atomic.Int32
+	started := make(chan struct{})
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if calls.Add(1) == 1 {
+			close(started)
+			select {
+			case <-r.Context().Done():
+			case <-time.After(5 * time.Second):
+			}
+			return
+		}
+		_, _ = w.Write([]byte(`{"organization":{"id":1,"name":"acme"}}`))
+	}))
+	defer srv.Close()
+
+	c := New(Config{Endpoint: srv.URL, MaxRetries: -1})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	leaderErr := make(chan error, 1)
+	go func() {
+		_, err := c.GetOrganization(ctx, "1")
+		leaderErr <- err
+	}()
+	<-started
+
+	waiterErr := make(chan error, 1)
+	go func() {
+		_, err := c.GetOrganization(context.Background(), "1")
+		waiterErr <- err
+	}()
+	time.Sleep(50 * time.Millisecond)
+	cancel()
+
+	if err := <-leaderErr; !errors.Is(err, context.Canceled) {
+		t.Errorf("leader err = %v, want context.Canceled", err)
+	}
+	if err := <-waiterErr; err != nil {
+		t.Errorf("waiter err = %v, want its own successful read", err)
+	}
+	if got := calls.Load(); got != 2 {
+		t.Errorf("HTTP calls = %d, want 2", got)
+	}
+}
+
+func TestReadCacheInvalidatedByWrites(t *testing.T) {
+	var gets atomic.Int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case http.MethodGet:
+			gets.Add(1)
+			if r.URL.Path == "/v1/organizations/1/vaults" {
+				_, _ = w.Write([]byte(`{"vaults":[],"has_more":false}`))
+				return
+			}
+			_, _ = w.Write([]byte(`{"id":2,"name":"prod"}`))
+		case http.MethodPatch:
+			_, _ = w.Write([]byte(`{"vault":{"id":2,"name":"prod"}}`))
+		}
+	}))
+	defer srv.Close()
+
+	c := New(Config{Endpoint: srv.URL})
+	ctx := context.Background()
+
+	read := func() {
+		t.Helper()
+		if _, err := c.GetVault(ctx, "1", "2"); err != nil {
+			t.Fatalf("GetVault: %v", err)
+		}
+		if _, err := c.ListVaults(ctx, "1"); err != nil {
+			t.Fatalf("ListVaults: %v", err)
+		}
+	}
+
+	read()
+	read()
+	if got := gets.Load(); got != 2 {
+		t.Fatalf("GETs after cached reads = %d, want 2", got)
+	}
+
+	// Updating the vault invalidates the vault and the vault list.
+	if _, err := c.UpdateVault(ctx, "1", "2", UpdateVaultRequest{Name: "prod"}); err != nil {
+		t.Fatalf("UpdateVault: %v", err)
+	}
+	read()
+	if got := gets.Load(); got != 4 {
+		t.Errorf("GETs after write = %d, want 4", got)
+	}
+}
+
+func TestReadCacheExpiresAndCanBeDisabled(t *testing.T) {
+	var gets atomic.Int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gets.Add(1)
+		_, _ = w.Write([]byte(`{"id":3,"name":"ops"}`))
+	}))
+	defer srv.Close()
+
+	for name, tt := range map[string]struct {
+		ttl  time.Duration
+		want int32
+	}{
+		"expired":  {ttl: time.Nanosecond, want: 2},
+		"disabled": {ttl: -1, want: 2},
+		"fresh":    {ttl: time.Minute, want: 1},
+	} {
+		gets.Store(0)
+		c := New(Config{Endpoint: srv.URL, CacheTTL: tt.ttl})
+		for range 2 {
+			if _, err := c.GetTeam(context.Background(), "1", "3"); err != nil {
+				t.Fatalf("%s: GetTeam: %v", name, err)
+			}
+		}
+		if got := gets.Load(); got != tt.want {
+			t.Errorf("%s: GETs = %d, want %d", name, got, tt.want)
+		}
+	}
+}
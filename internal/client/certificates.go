@@ -12,7 +12,7 @@ import (
 // The private key is returned ONLY in the response to this call and cannot be retrieved later.
 func (c *Client) CreateCertificate(ctx context.Context, orgID, clientID string, req CreateCertificateRequest) (*ClientCertificate, error) {
 	var cert ClientCertificate
-	if err := c.post(ctx, fmt.Sprintf("/v1/organizations/%s/clients/%s/certificates", orgID, clientID), req, &cert); err != nil {
+	if err := c.create(ctx, fmt.Sprintf("/v1/organizations/%s/clients/%s/certificates", orgID, clientID), req, &cert); err != nil {
 		return nil, fmt.Errorf("failed to create certificate: %w", err)
 	}
 	return &cert, nil
@@ -43,10 +43,10 @@ func (c *Client) RevokeCertificate(ctx context.Context, orgID, clientID, certID
 	return nil
 }
 
-// ListCertificates lists all certificates for a client.
+// ListCertificates lists all certificates for a client, fetching every page.
 func (c *Client) ListCertificates(ctx context.Context, orgID, clientID string) ([]ClientCertificate, error) {
-	var certs []ClientCertificate
-	if err := c.get(ctx, fmt.Sprintf("/v1/organizations/%s/clients/%s/certificates", orgID, clientID), &certs); err != nil {
+	certs, err := listAll[ClientCertificate](ctx, c, fmt.Sprintf("/v1/organizations/%s/clients/%s/certificates", orgID, clientID), "certificates")
+	if err != nil {
 		return nil, fmt.Errorf("failed to list certificates: %w", err)
 	}
 	return certs, nil
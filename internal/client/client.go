@@ -11,127 +11,452 @@ import (
 	"fmt"
 	"io"
 	"net/http"
+	"sync/atomic"
 	"time"
 )
 
 // Client is the InferaDB Control API client.
 type Client struct {
 	httpClient   *http.Client
+	timeout      time.Duration
 	baseURL      string
-	sessionToken string
+	headers      map[string]string
+	headerNames  map[string]bool
+	logger       Logger
+	cache        *readCache
+	lists        *readCache
+	auth         Authenticator
+	maxRetries   int
+	retryMaxWait time.Duration
+	limiter      *limiter
+	pageSize     int
+	reauthHint   string
+
+	// authFailure holds the *AuthError of the first request whose
+	// credentials were rejected.
+	authFailure atomic.Pointer[AuthError]
 }
 
 // Config holds the configuration for creating a new Client.
 type Config struct {
-	Endpoint     string
+	Endpoint string
+
+	// SessionToken authenticates requests with an infera_session cookie.
+	// It is ignored when Authenticator is set.
 	SessionToken string
+
+	// Authenticator adds credentials to every request. When nil, SessionToken
+	// is sent as a session cookie.
+	Authenticator Authenticator
+
+	// Transport sends the requests. When nil, http.DefaultTransport is used;
+	// see NewTransport for custom CAs, mutual TLS and proxies.
+	Transport http.RoundTripper
+
+	// Timeout bounds each attempt of a request. When the context carries a
+	// deadline, such as a resource operation timeout, an attempt also ends
+	// at that deadline, and the attempts together cannot outlast it. Zero
+	// selects DefaultTimeout.
+	Timeout time.Duration
+
+	// Headers are added to every request. They cannot override the
+	// credentials set by Authenticator. Their values, often credentials of
+	// a gateway, are redacted in logged requests and responses.
+	Headers map[string]string
+
+	// CacheTTL is how long GET responses, and the collection listings the
+	// Lookup methods are served from, are reused. Identical concurrent reads
+	// share one request, and writes invalidate cached results for the same
+	// path, its ancestors and its descendants. Zero selects DefaultCacheTTL;
+	// a negative value disables caching.
+	CacheTTL time.Duration
+
+	// Logger, when set, receives every request attempt with credentials and
+	// secret fields redacted.
+	Logger Logger
+
+	// MaxRetries is the number of times a transient failure is retried.
+	// Zero selects DefaultMaxRetries; a negative value disables retries.
+	MaxRetries int
+
+	// RetryMaxWait caps the delay between two attempts, including delays
+	// requested by the server through Retry-After. Zero selects DefaultRetryMaxWait.
+	RetryMaxWait time.Duration
+
+	// RequestsPerSecond paces requests with a token bucket. Zero disables pacing.
+	RequestsPerSecond float64
+
+	// Burst is the token bucket size. Zero allows one second worth of requests.
+	Burst int
+
+	// MaxInFlight bounds the number of concurrent requests. The bound is
+	// lowered automatically while the API answers with 429 Too Many Requests.
+	// Zero selects DefaultMaxInFlight; a negative value removes the bound.
+	MaxInFlight int
+
+	// PageSize is the number of items requested per page by List methods,
+	// which follow pagination until every item has been fetched.
+	// Zero selects DefaultPageSize.
+	PageSize int
+
+	// ReauthHint is appended to the error returned when the API rejects the
+	// credentials and they cannot be refreshed, e.g. "Run `inferadb login`".
+	ReauthHint string
 }
 
 // New creates a new InferaDB API client.
 func New(cfg Config) *Client {
-	return &Client{
-		httpClient: &http.Client{
-			Timeout: 30 * time.Second,
-		},
+	maxRetries := cfg.MaxRetries
+	switch {
+	case maxRetries == 0:
+		maxRetries = DefaultMaxRetries
+	case maxRetries < 0:
+		maxRetries = 0
+	}
+
+	retryMaxWait := cfg.RetryMaxWait
+	if retryMaxWait <= 0 {
+		retryMaxWait = DefaultRetryMaxWait
+	}
+
+	maxInFlight := cfg.MaxInFlight
+	if maxInFlight == 0 {
+		maxInFlight = DefaultMaxInFlight
+	}
+
+	auth := cfg.Authenticator
+	if auth == nil && cfg.SessionToken != "" {
+		auth = SessionCookieAuth{Token: cfg.SessionToken}
+	}
+
+	pageSize := cfg.PageSize
+	if pageSize <= 0 {
+		pageSize = DefaultPageSize
+	}
+
+	timeout := cfg.Timeout
+	if timeout <= 0 {
+		timeout = DefaultTimeout
+	}
+
+	headerNames := make(map[string]bool, len(cfg.Headers))
+	for name := range cfg.Headers {
+		headerNames[http.CanonicalHeaderKey(name)] = true
+	}
+
+	c := &Client{
+		httpClient:   &http.Client{Transport: cfg.Transport},
+		timeout:      timeout,
 		baseURL:      cfg.Endpoint,
-		sessionToken: cfg.SessionToken,
+		headers:      cfg.Headers,
+		headerNames:  headerNames,
+		logger:       cfg.Logger,
+		cache:        newReadCache(cfg.CacheTTL),
+		lists:        newReadCache(cfg.CacheTTL),
+		auth:         auth,
+		maxRetries:   maxRetries,
+		retryMaxWait: retryMaxWait,
+		limiter:      newLimiter(cfg.RequestsPerSecond, cfg.Burst, maxInFlight),
+		pageSize:     pageSize,
+		reauthHint:   cfg.ReauthHint,
 	}
+
+	// Authenticators that exchange credentials with the API need a client
+	// to send the exchange request through.
+	if b, ok := auth.(clientBinder); ok {
+		b.bindClient(c)
+	}
+
+	return c
 }
 
-// APIError represents an error response from the API.
-type APIError struct {
-	StatusCode int
-	Message    string
-	Code       string
+// clientBinder is implemented by authenticators that call the API themselves.
+type clientBinder interface {
+	bindClient(c *Client)
 }
 
-func (e *APIError) Error() string {
-	if e.Code != "" {
-		return fmt.Sprintf("InferaDB API error (%d): %s - %s", e.StatusCode, e.Code, e.Message)
-	}
-	return fmt.Sprintf("InferaDB API error (%d): %s", e.StatusCode, e.Message)
+// requestOptions customizes a single call to doRequest.
+type requestOptions struct {
+	// skipAuth sends the request without credentials.
+	skipAuth bool
+
+	// skipCache sends a GET even when a cached response is available.
+	skipCache bool
+
+	// skipLimiter bypasses rate limiting. Token exchanges performed while
+	// authenticating another request must not wait for the slot that
+	// request already holds.
+	skipLimiter bool
+
+	// idempotent marks a non-idempotent method as safe to retry.
+	idempotent bool
+
+	// idempotencyKey is sent in the Idempotency-Key header when set.
+	idempotencyKey string
+
+	// ifMatch is sent in the If-Match header when set.
+	ifMatch string
+
+	// etag receives the ETag header of a successful response.
+	etag *string
 }
 
-// IsNotFound returns true if the error is a 404 Not Found error.
-func (e *APIError) IsNotFound() bool {
-	return e.StatusCode == http.StatusNotFound
+// requestOption sets a field of requestOptions.
+type requestOption func(*requestOptions)
+
+// asTokenExchange marks a request that obtains credentials: it is sent
+// unauthenticated, outside the rate limiter, and may be retried.
+func asTokenExchange() requestOption {
+	return func(o *requestOptions) {
+		o.skipAuth = true
+		o.skipLimiter = true
+		o.idempotent = true
+	}
 }
 
-// errorResponse is the JSON structure for API error responses.
-type errorResponse struct {
-	Error   string `json:"error"`
-	Code    string `json:"code,omitempty"`
-	Message string `json:"message,omitempty"`
+// uncached marks a GET whose response must reflect the current server state,
+// such as a poll for a status change.
+func uncached() requestOption {
+	return func(o *requestOptions) {
+		o.skipCache = true
+	}
 }
 
 // doRequest performs an HTTP request and handles common error cases.
-func (c *Client) doRequest(ctx context.Context, method, path string, body interface{}, result interface{}) error {
-	var bodyReader io.Reader
+// Transient failures are retried with jittered exponential backoff, and
+// credentials rejected with 401 are refreshed once when the authenticator
+// supports it.
+func (c *Client) doRequest(ctx context.Context, method, path string, body interface{}, result interface{}, opts ...requestOption) error {
+	var options requestOptions
+	for _, opt := range opts {
+		opt(&options)
+	}
+
+	var payload []byte
 	if body != nil {
 		jsonBody, err := json.Marshal(body)
 		if err != nil {
 			return fmt.Errorf("failed to marshal request body: %w", err)
 		}
-		bodyReader = bytes.NewReader(jsonBody)
+		payload = jsonBody
 	}
 
-	req, err := http.NewRequestWithContext(ctx, method, c.baseURL+path, bodyReader)
+	var (
+		res *response
+		err error
+	)
+	if method == http.MethodGet && c.cache != nil && !options.skipAuth && !options.skipCache {
+		var cached interface{}
+		cached, err = c.cache.get(ctx, path, func() (interface{}, error) {
+			return c.execute(ctx, method, path, payload, &options)
+		})
+		res, _ = cached.(*response)
+	} else {
+		res, err = c.execute(ctx, method, path, payload, &options)
+		if method != http.MethodGet && c.cache != nil {
+			// Writes invalidate even when they fail: the outcome is unknown.
+			c.cache.invalidate(path)
+			c.lists.invalidate(path)
+		}
+	}
 	if err != nil {
-		return fmt.Errorf("failed to create request: %w", err)
+		return err
 	}
+	respBody := res.body
 
-	req.Header.Set("Content-Type", "application/json")
-	req.Header.Set("Accept", "application/json")
+	if result != nil && len(respBody) > 0 {
+		if err := json.Unmarshal(respBody, result); err != nil {
+			return fmt.Errorf("failed to unmarshal response: %w", err)
+		}
+	}
+	if options.etag != nil {
+		*options.etag = res.etag
+	}
 
-	if c.sessionToken != "" {
-		req.AddCookie(&http.Cookie{
-			Name:  "infera_session",
-			Value: c.sessionToken,
-		})
+	return nil
+}
+
+// response is the part of a successful response kept by doRequest and the
+// read cache.
+type response struct {
+	body []byte
+	etag string
+}
+
+// execute sends a request, refreshing rejected credentials once, and turns
+// error responses into errors.
+func (c *Client) execute(ctx context.Context, method, path string, payload []byte, options *requestOptions) (*response, error) {
+	if !options.skipAuth {
+		if failure := c.authFailure.Load(); failure != nil {
+			return nil, failure.repeated()
+		}
 	}
 
-	resp, err := c.httpClient.Do(req)
-	if err != nil {
-		return fmt.Errorf("request failed: %w", err)
+	resp, respBody, err := c.sendWithRetries(ctx, method, path, payload, options)
+
+	// Renew rejected credentials once and replay the request.
+	var refreshErr error
+	if err == nil && resp.StatusCode == http.StatusUnauthorized && !options.skipAuth {
+		if refresher, ok := c.auth.(Refresher); ok {
+			refreshErr = refresher.Refresh(ctx, resp.Request)
+			if refreshErr == nil {
+				resp, respBody, err = c.sendWithRetries(ctx, method, path, payload, options)
+			}
+		}
 	}
-	defer func() { _ = resp.Body.Close() }()
 
-	respBody, err := io.ReadAll(resp.Body)
+	switch {
+	case err != nil:
+		err = fmt.Errorf("request failed: %w", err)
+	case resp.StatusCode >= 400:
+		err = newAPIError(resp.StatusCode, respBody)
+		if refreshErr != nil {
+			err = fmt.Errorf("%w (refreshing credentials failed: %v)", err, refreshErr)
+		}
+	}
 	if err != nil {
-		return fmt.Errorf("failed to read response body: %w", err)
+		if IsUnauthorized(err) && !options.skipAuth {
+			return nil, c.failAuthentication(err)
+		}
+		return nil, err
 	}
 
-	if resp.StatusCode >= 400 {
-		var errResp errorResponse
-		if err := json.Unmarshal(respBody, &errResp); err != nil {
-			return &APIError{
-				StatusCode: resp.StatusCode,
-				Message:    string(respBody),
+	return &response{body: respBody, etag: resp.Header.Get("ETag")}, nil
+}
+
+// sendWithRetries sends a request, retrying transient failures with jittered
+// exponential backoff.
+func (c *Client) sendWithRetries(ctx context.Context, method, path string, payload []byte, options *requestOptions) (*http.Response, []byte, error) {
+	var (
+		resp     *http.Response
+		respBody []byte
+		err      error
+	)
+	idempotent := options.idempotent || isIdempotent(method)
+	for attempt := 0; ; attempt++ {
+		if !options.skipLimiter {
+			if err := c.limiter.acquire(ctx); err != nil {
+				return nil, nil, err
 			}
 		}
-		msg := errResp.Message
-		if msg == "" {
-			msg = errResp.Error
+		resp, respBody, err = c.send(ctx, method, path, payload, options, attempt)
+		if !options.skipLimiter {
+			c.limiter.release(resp != nil && resp.StatusCode == http.StatusTooManyRequests)
+		}
+		if ctx.Err() != nil {
+			break
+		}
+		wait, retry := c.retryDelay(idempotent, attempt, resp, err)
+		if !retry {
+			break
 		}
-		return &APIError{
-			StatusCode: resp.StatusCode,
-			Message:    msg,
-			Code:       errResp.Code,
+		if err := sleep(ctx, wait); err != nil {
+			return nil, nil, err
 		}
 	}
+	return resp, respBody, err
+}
 
-	if result != nil && len(respBody) > 0 {
-		if err := json.Unmarshal(respBody, result); err != nil {
-			return fmt.Errorf("failed to unmarshal response: %w", err)
+// failAuthentication records that the API rejected the client's credentials
+// and returns the error for the request. Concurrent and later requests get
+// a Repeated copy of the first failure.
+func (c *Client) failAuthentication(err error) error {
+	failure := &AuthError{Err: err, Hint: c.reauthHint}
+	if c.authFailure.CompareAndSwap(nil, failure) {
+		return failure
+	}
+	return c.authFailure.Load().repeated()
+}
+
+// send performs a single attempt of a request and reads the full response body.
+func (c *Client) send(ctx context.Context, method, path string, payload []byte, options *requestOptions, attempt int) (*http.Response, []byte, error) {
+	var bodyReader io.Reader
+	if payload != nil {
+		bodyReader = bytes.NewReader(payload)
+	}
+
+	// The attempt ends at the earlier of the request timeout and the
+	// deadline of ctx, so a hung attempt is retried while time remains.
+	ctx, cancel := context.WithTimeout(ctx, c.timeout)
+	defer cancel()
+
+	req, err := http.NewRequestWithContext(ctx, method, c.baseURL+path, bodyReader)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to create request: %w", err)
+	}
+
+	for name, value := range c.headers {
+		req.Header.Set(name, value)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Accept", "application/json")
+	if options.idempotencyKey != "" {
+		req.Header.Set(IdempotencyKeyHeader, options.idempotencyKey)
+	}
+	if options.ifMatch != "" {
+		req.Header.Set("If-Match", options.ifMatch)
+	}
+
+	// Headers set by the authenticator are credentials and never logged.
+	unauthenticated := req.Header
+	if c.logger != nil {
+		unauthenticated = req.Header.Clone()
+	}
+	if c.auth != nil && !options.skipAuth {
+		if err := c.auth.Authenticate(ctx, req); err != nil {
+			return nil, nil, &authenticationError{err: err}
 		}
 	}
 
-	return nil
+	start := time.Now()
+	resp, respBody, err := c.roundTrip(req)
+
+	if c.logger != nil {
+		// Configured headers may carry gateway credentials and are redacted
+		// like those set by the authenticator.
+		secret := changedHeaders(unauthenticated, req.Header)
+		for name := range c.headerNames {
+			secret[name] = true
+		}
+		entry := RequestLog{
+			Method:         method,
+			Path:           path,
+			Attempt:        attempt,
+			RequestHeaders: redactHeaders(req.Header, secret),
+			RequestBody:    redactBody(payload),
+			Duration:       time.Since(start),
+			Err:            err,
+		}
+		if resp != nil {
+			entry.StatusCode = resp.StatusCode
+			entry.ResponseHeaders = redactHeaders(resp.Header, c.headerNames)
+			entry.ResponseBody = redactBody(respBody)
+		}
+		c.logger.LogRequest(ctx, entry)
+	}
+
+	return resp, respBody, err
+}
+
+// roundTrip sends req and reads the full response body.
+func (c *Client) roundTrip(req *http.Request) (*http.Response, []byte, error) {
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return nil, nil, err
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to read response body: %w", err)
+	}
+
+	return resp, respBody, nil
 }
 
 // get performs a GET request.
-func (c *Client) get(ctx context.Context, path string, result interface{}) error {
-	return c.doRequest(ctx, http.MethodGet, path, nil, result)
+func (c *Client) get(ctx context.Context, path string, result interface{}, opts ...requestOption) error {
+	return c.doRequest(ctx, http.MethodGet, path, nil, result, opts...)
 }
 
 // post performs a POST request.
@@ -140,8 +465,8 @@ func (c *Client) post(ctx context.Context, path string, body interface{}, result
 }
 
 // patch performs a PATCH request.
-func (c *Client) patch(ctx context.Context, path string, body interface{}, result interface{}) error {
-	return c.doRequest(ctx, http.MethodPatch, path, body, result)
+func (c *Client) patch(ctx context.Context, path string, body interface{}, result interface{}, opts ...requestOption) error {
+	return c.doRequest(ctx, http.MethodPatch, path, body, result, opts...)
 }
 
 // delete performs a DELETE request.
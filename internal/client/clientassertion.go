@@ -0,0 +1,148 @@
+// Copyright 2025 InferaDB
+// SPDX-License-Identifier: Apache-2.0
+
+package client
+
+import (
+	"context"
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+)
+
+// clientAssertionType identifies a JWT client assertion (RFC 7523).
+const clientAssertionType = "urn:ietf:params:oauth:client-assertion-type:jwt-bearer"
+
+// clientAssertionLifetime is the validity of a signed assertion. Assertions
+// are exchanged immediately, so it only needs to cover clock skew.
+const clientAssertionLifetime = 5 * time.Minute
+
+// ClientAssertionAuth authenticates as an InferaDB client (a service account)
+// using one of its Ed25519 certificates. It signs a short-lived JWT with the
+// certificate's private key, exchanges it for an access token at the token
+// endpoint, and sends that token as a bearer token until shortly before it
+// expires.
+//
+// Use NewClientAssertionAuth to create one; the zero value is not usable.
+type ClientAssertionAuth struct {
+	clientID string
+	keyID    string
+	key      ed25519.PrivateKey
+
+	client *Client
+	tokens tokenCache
+}
+
+// NewClientAssertionAuth returns an authenticator for the client clientID
+// holding the certificate keyID. privateKeyPEM is the PKCS#8 PEM encoded
+// Ed25519 private key returned when the certificate was created.
+func NewClientAssertionAuth(clientID, keyID, privateKeyPEM string) (*ClientAssertionAuth, error) {
+	if clientID == "" {
+		return nil, errors.New("client ID is required")
+	}
+	if keyID == "" {
+		return nil, errors.New("certificate key ID is required")
+	}
+
+	block, _ := pem.Decode([]byte(privateKeyPEM))
+	if block == nil {
+		return nil, errors.New("private key is not PEM encoded")
+	}
+	parsed, err := x509.ParsePKCS8PrivateKey(block.Bytes)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse private key: %w", err)
+	}
+	key, ok := parsed.(ed25519.PrivateKey)
+	if !ok {
+		return nil, fmt.Errorf("private key is %T, expected an Ed25519 key", parsed)
+	}
+
+	return &ClientAssertionAuth{clientID: clientID, keyID: keyID, key: key}, nil
+}
+
+// Authenticate implements Authenticator.
+func (a *ClientAssertionAuth) Authenticate(ctx context.Context, req *http.Request) error {
+	if a.client == nil {
+		return errors.New("client assertion authenticator is not bound to a client")
+	}
+
+	token, err := a.tokens.get(ctx, a.exchange)
+	if err != nil {
+		return fmt.Errorf("failed to obtain access token for client %s: %w", a.clientID, err)
+	}
+	req.Header.Set("Authorization", "Bearer "+token)
+	return nil
+}
+
+// Refresh implements Refresher by obtaining a new access token.
+func (a *ClientAssertionAuth) Refresh(ctx context.Context, rejected *http.Request) error {
+	return a.tokens.refresh(ctx, bearerToken(rejected), a.exchange)
+}
+
+func (a *ClientAssertionAuth) bindClient(c *Client) {
+	a.client = c
+}
+
+// exchange trades a freshly signed assertion for an access token.
+func (a *ClientAssertionAuth) exchange(ctx context.Context) (*TokenResponse, error) {
+	assertion, err := a.sign(time.Now())
+	if err != nil {
+		return nil, err
+	}
+
+	req := ClientAssertionTokenRequest{
+		GrantType:           "client_credentials",
+		ClientAssertionType: clientAssertionType,
+		ClientAssertion:     assertion,
+	}
+	var resp TokenResponse
+	if err := a.client.doRequest(ctx, http.MethodPost, tokenPath, req, &resp, asTokenExchange()); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// sign returns a compact EdDSA JWT asserting the client's identity to the
+// token endpoint.
+func (a *ClientAssertionAuth) sign(now time.Time) (string, error) {
+	jti := make([]byte, 16)
+	if _, err := rand.Read(jti); err != nil {
+		return "", fmt.Errorf("failed to generate assertion ID: %w", err)
+	}
+
+	header := map[string]string{
+		"alg": "EdDSA",
+		"typ": "JWT",
+		"kid": a.keyID,
+	}
+	claims := map[string]interface{}{
+		"iss": a.clientID,
+		"sub": a.clientID,
+		"aud": a.client.baseURL + tokenPath,
+		"iat": now.Unix(),
+		"exp": now.Add(clientAssertionLifetime).Unix(),
+		"jti": hex.EncodeToString(jti),
+	}
+
+	var parts []string
+	for _, v := range []interface{}{header, claims} {
+		data, err := json.Marshal(v)
+		if err != nil {
+			return "", fmt.Errorf("failed to encode assertion: %w", err)
+		}
+		parts = append(parts, base64.RawURLEncoding.EncodeToString(data))
+	}
+
+	signingInput := strings.Join(parts, ".")
+	signature := ed25519.Sign(a.key, []byte(signingInput))
+	return signingInput + "." + base64.RawURLEncoding.EncodeToString(signature), nil
+}
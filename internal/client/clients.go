@@ -11,7 +11,7 @@ import (
 // CreateClient creates a new client (backend service identity) in an organization.
 func (c *Client) CreateClient(ctx context.Context, orgID string, req CreateClientRequest) (*InferaClient, error) {
 	var resp ClientResponse
-	if err := c.post(ctx, fmt.Sprintf("/v1/organizations/%s/clients", orgID), req, &resp); err != nil {
+	if err := c.create(ctx, fmt.Sprintf("/v1/organizations/%s/clients", orgID), req, &resp); err != nil {
 		return nil, fmt.Errorf("failed to create client: %w", err)
 	}
 	return &resp.Client, nil
@@ -51,11 +51,11 @@ func (c *Client) DeactivateClient(ctx context.Context, orgID, clientID string) e
 	return nil
 }
 
-// ListClients lists all clients in an organization.
+// ListClients lists all clients in an organization, fetching every page.
 func (c *Client) ListClients(ctx context.Context, orgID string) ([]InferaClient, error) {
-	var resp ClientListResponse
-	if err := c.get(ctx, fmt.Sprintf("/v1/organizations/%s/clients", orgID), &resp); err != nil {
+	clients, err := listAll[InferaClient](ctx, c, fmt.Sprintf("/v1/organizations/%s/clients", orgID), "clients")
+	if err != nil {
 		return nil, fmt.Errorf("failed to list clients: %w", err)
 	}
-	return resp.Clients, nil
+	return clients, nil
 }
@@ -0,0 +1,284 @@
+// Copyright 2025 InferaDB
+// SPDX-License-Identifier: Apache-2.0
+
+package client
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+// updateGolden rewrites the golden files of the contract tests from the
+// current decoding: go test ./internal/client -run TestContract -update
+var updateGolden = flag.Bool("update", false, "rewrite golden files of the contract tests")
+
+// IDs used by the fixtures in testdata/contract.
+const (
+	contractOrg    = "7160832093298688"
+	contractVault  = "7160832110075904"
+	contractClient = "7160832143630336"
+	contractTeam   = "7160832126853120"
+	contractCert   = "7160832177184768"
+	contractMember = "7160832193961984"
+	contractUGrant = "7160832210739200"
+	contractTGrant = "7160832227516416"
+)
+
+// contractCase pins the response shape of one endpoint. The fixture
+// testdata/contract/<fixture>.json is the response body as sent by the
+// Control API; <fixture>.golden is the model the client decodes from it.
+type contractCase struct {
+	fixture string
+	method  string
+	path    string
+	call    func(ctx context.Context, c *Client) (interface{}, error)
+}
+
+var contractCases = []contractCase{
+	{"organization_create", http.MethodPost, "/v1/organizations", func(ctx context.Context, c *Client) (interface{}, error) {
+		return c.CreateOrganization(ctx, CreateOrganizationRequest{Name: "acme", Tier: "pro"})
+	}},
+	{"organization_get", http.MethodGet, "/v1/organizations/" + contractOrg, func(ctx context.Context, c *Client) (interface{}, error) {
+		return c.GetOrganization(ctx, contractOrg)
+	}},
+	{"organization_update", http.MethodPatch, "/v1/organizations/" + contractOrg, func(ctx context.Context, c *Client) (interface{}, error) {
+		return c.UpdateOrganization(ctx, contractOrg, UpdateOrganizationRequest{Name: "acme"})
+	}},
+	{"organization_list", http.MethodGet, "/v1/organizations", func(ctx context.Context, c *Client) (interface{}, error) {
+		return c.ListOrganizations(ctx)
+	}},
+
+	{"vault_create", http.MethodPost, "/v1/organizations/" + contractOrg + "/vaults", func(ctx context.Context, c *Client) (interface{}, error) {
+		return c.CreateVault(ctx, contractOrg, CreateVaultRequest{Name: "prod"})
+	}},
+	{"vault_get", http.MethodGet, "/v1/organizations/" + contractOrg + "/vaults/" + contractVault, func(ctx context.Context, c *Client) (interface{}, error) {
+		return c.GetVault(ctx, contractOrg, contractVault)
+	}},
+	{"vault_update", http.MethodPatch, "/v1/organizations/" + contractOrg + "/vaults/" + contractVault, func(ctx context.Context, c *Client) (interface{}, error) {
+		return c.UpdateVault(ctx, contractOrg, contractVault, UpdateVaultRequest{Name: "prod"})
+	}},
+	{"vault_list", http.MethodGet, "/v1/organizations/" + contractOrg + "/vaults", func(ctx context.Context, c *Client) (interface{}, error) {
+		return c.ListVaults(ctx, contractOrg)
+	}},
+
+	{"client_create", http.MethodPost, "/v1/organizations/" + contractOrg + "/clients", func(ctx context.Context, c *Client) (interface{}, error) {
+		return c.CreateClient(ctx, contractOrg, CreateClientRequest{Name: "backend", VaultID: contractVault})
+	}},
+	{"client_get", http.MethodGet, "/v1/organizations/" + contractOrg + "/clients/" + contractClient, func(ctx context.Context, c *Client) (interface{}, error) {
+		return c.GetClient(ctx, contractOrg, contractClient)
+	}},
+	{"client_update", http.MethodPatch, "/v1/organizations/" + contractOrg + "/clients/" + contractClient, func(ctx context.Context, c *Client) (interface{}, error) {
+		return c.UpdateClient(ctx, contractOrg, contractClient, UpdateClientRequest{Name: "backend"})
+	}},
+	{"client_list", http.MethodGet, "/v1/organizations/" + contractOrg + "/clients", func(ctx context.Context, c *Client) (interface{}, error) {
+		return c.ListClients(ctx, contractOrg)
+	}},
+
+	{"certificate_create", http.MethodPost, "/v1/organizations/" + contractOrg + "/clients/" + contractClient + "/certificates", func(ctx context.Context, c *Client) (interface{}, error) {
+		return c.CreateCertificate(ctx, contractOrg, contractClient, CreateCertificateRequest{Name: "key-1"})
+	}},
+	{"certificate_get", http.MethodGet, "/v1/organizations/" + contractOrg + "/clients/" + contractClient + "/certificates/" + contractCert, func(ctx context.Context, c *Client) (interface{}, error) {
+		return c.GetCertificate(ctx, contractOrg, contractClient, contractCert)
+	}},
+	{"certificate_list", http.MethodGet, "/v1/organizations/" + contractOrg + "/clients/" + contractClient + "/certificates", func(ctx context.Context, c *Client) (interface{}, error) {
+		return c.ListCertificates(ctx, contractOrg, contractClient)
+	}},
+
+	{"team_create", http.MethodPost, "/v1/organizations/" + contractOrg + "/teams", func(ctx context.Context, c *Client) (interface{}, error) {
+		return c.CreateTeam(ctx, contractOrg, CreateTeamRequest{Name: "eng"})
+	}},
+	{"team_get", http.MethodGet, "/v1/organizations/" + contractOrg + "/teams/" + contractTeam, func(ctx context.Context, c *Client) (interface{}, error) {
+		return c.GetTeam(ctx, contractOrg, contractTeam)
+	}},
+	{"team_update", http.MethodPatch, "/v1/organizations/" + contractOrg + "/teams/" + contractTeam, func(ctx context.Context, c *Client) (interface{}, error) {
+		return c.UpdateTeam(ctx, contractOrg, contractTeam, UpdateTeamRequest{Name: "eng"})
+	}},
+	{"team_list", http.MethodGet, "/v1/organizations/" + contractOrg + "/teams", func(ctx context.Context, c *Client) (interface{}, error) {
+		return c.ListTeams(ctx, contractOrg)
+	}},
+
+	{"team_member_add", http.MethodPost, "/v1/organizations/" + contractOrg + "/teams/" + contractTeam + "/members", func(ctx context.Context, c *Client) (interface{}, error) {
+		return c.AddTeamMember(ctx, contractOrg, contractTeam, AddTeamMemberRequest{UserID: "7160832076521472", Role: "maintainer"})
+	}},
+	{"team_member_get", http.MethodGet, "/v1/organizations/" + contractOrg + "/teams/" + contractTeam + "/members/" + contractMember, func(ctx context.Context, c *Client) (interface{}, error) {
+		return c.GetTeamMember(ctx, contractOrg, contractTeam, contractMember)
+	}},
+	{"team_member_update", http.MethodPatch, "/v1/organizations/" + contractOrg + "/teams/" + contractTeam + "/members/" + contractMember, func(ctx context.Context, c *Client) (interface{}, error) {
+		return c.UpdateTeamMember(ctx, contractOrg, contractTeam, contractMember, UpdateTeamMemberRequest{Role: "maintainer"})
+	}},
+	{"team_member_list", http.MethodGet, "/v1/organizations/" + contractOrg + "/teams/" + contractTeam + "/members", func(ctx context.Context, c *Client) (interface{}, error) {
+		return c.ListTeamMembers(ctx, contractOrg, contractTeam)
+	}},
+
+	{"vault_user_grant_create", http.MethodPost, "/v1/organizations/" + contractOrg + "/vaults/" + contractVault + "/user-grants", func(ctx context.Context, c *Client) (interface{}, error) {
+		return c.CreateVaultUserGrant(ctx, contractOrg, contractVault, CreateVaultUserGrantRequest{UserID: "7160832160407552", Role: "reader"})
+	}},
+	{"vault_user_grant_get", http.MethodGet, "/v1/organizations/" + contractOrg + "/vaults/" + contractVault + "/user-grants/" + contractUGrant, func(ctx context.Context, c *Client) (interface{}, error) {
+		return c.GetVaultUserGrant(ctx, contractOrg, contractVault, contractUGrant)
+	}},
+	{"vault_user_grant_update", http.MethodPatch, "/v1/organizations/" + contractOrg + "/vaults/" + contractVault + "/user-grants/" + contractUGrant, func(ctx context.Context, c *Client) (interface{}, error) {
+		return c.UpdateVaultUserGrant(ctx, contractOrg, contractVault, contractUGrant, UpdateVaultUserGrantRequest{Role: "reader"})
+	}},
+	{"vault_user_grant_list", http.MethodGet, "/v1/organizations/" + contractOrg + "/vaults/" + contractVault + "/user-grants", func(ctx context.Context, c *Client) (interface{}, error) {
+		return c.ListVaultUserGrants(ctx, contractOrg, contractVault)
+	}},
+
+	{"vault_team_grant_create", http.MethodPost, "/v1/organizations/" + contractOrg + "/vaults/" + contractVault + "/team-grants", func(ctx context.Context, c *Client) (interface{}, error) {
+		return c.CreateVaultTeamGrant(ctx, contractOrg, contractVault, CreateVaultTeamGrantRequest{TeamID: contractTeam, Role: "writer"})
+	}},
+	{"vault_team_grant_get", http.MethodGet, "/v1/organizations/" + contractOrg + "/vaults/" + contractVault + "/team-grants/" + contractTGrant, func(ctx context.Context, c *Client) (interface{}, error) {
+		return c.GetVaultTeamGrant(ctx, contractOrg, contractVault, contractTGrant)
+	}},
+	{"vault_team_grant_update", http.MethodPatch, "/v1/organizations/" + contractOrg + "/vaults/" + contractVault + "/team-grants/" + contractTGrant, func(ctx context.Context, c *Client) (interface{}, error) {
+		return c.UpdateVaultTeamGrant(ctx, contractOrg, contractVault, contractTGrant, UpdateVaultTeamGrantRequest{Role: "writer"})
+	}},
+	{"vault_team_grant_list", http.MethodGet, "/v1/organizations/" + contractOrg + "/vaults/" + contractVault + "/team-grants", func(ctx context.Context, c *Client) (interface{}, error) {
+		return c.ListVaultTeamGrants(ctx, contractOrg, contractVault)
+	}},
+}
+
+func TestContract(t *testing.T) {
+	for _, tc := range contractCases {
+		t.Run(tc.fixture, func(t *testing.T) {
+			fixture, err := os.ReadFile(filepath.Join("testdata", "contract", tc.fixture+".json"))
+			if err != nil {
+				t.Fatalf("ReadFile: %v", err)
+			}
+
+			result, err := callContract(tc, fixture)
+			if err != nil {
+				t.Fatalf("%s %s: %v", tc.method, tc.path, err)
+			}
+			if err := checkDecoded(result); err != nil {
+				t.Fatalf("%s %s decoded %s: %v; the response envelope may have changed", tc.method, tc.path, tc.fixture+".json", err)
+			}
+
+			got, err := json.MarshalIndent(result, "", "  ")
+			if err != nil {
+				t.Fatalf("MarshalIndent: %v", err)
+			}
+			got = append(got, '\n')
+
+			golden := filepath.Join("testdata", "contract", tc.fixture+".golden")
+			if *updateGolden {
+				if err := os.WriteFile(golden, got, 0o644); err != nil {
+					t.Fatalf("WriteFile: %v", err)
+				}
+				return
+			}
+			want, err := os.ReadFile(golden)
+			if err != nil {
+				t.Fatalf("ReadFile: %v (run with -update to create it)", err)
+			}
+			if !bytes.Equal(got, want) {
+				t.Errorf("%s %s decoded differently from %s\ngot:\n%s\nwant:\n%s", tc.method, tc.path, golden, got, want)
+			}
+		})
+	}
+}
+
+// A wrapped response where a bare object is expected, or the reverse,
+// decodes into zero values. The contract check must catch it.
+func TestContractDetectsEnvelopeChange(t *testing.T) {
+	fixture, err := os.ReadFile(filepath.Join("testdata", "contract", "vault_update.json"))
+	if err != nil {
+		t.Fatalf("ReadFile: %v", err)
+	}
+
+	// GetVault expects the vault unwrapped; serve it wrapped instead.
+	get := contractCases[contractCaseIndex(t, "vault_get")]
+	result, err := callContract(get, fixture)
+	if err != nil {
+		t.Fatalf("GetVault: %v", err)
+	}
+	err = checkDecoded(result)
+	if err == nil || !strings.Contains(err.Error(), "id") {
+		t.Errorf("checkDecoded = %v, want zero-valued id reported", err)
+	}
+}
+
+// callContract serves fixture for tc's endpoint and returns what the client
+// decodes from it.
+func callContract(tc contractCase, fixture []byte) (interface{}, error) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != tc.method || r.URL.Path != tc.path {
+			http.Error(w, fmt.Sprintf("unexpected request %s %s", r.Method, r.URL.Path), http.StatusTeapot)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		if r.Method == http.MethodPost {
+			w.WriteHeader(http.StatusCreated)
+		}
+		_, _ = w.Write(fixture)
+	}))
+	defer srv.Close()
+
+	c := New(Config{Endpoint: srv.URL, SessionToken: "token", CacheTTL: -1, MaxRetries: -1})
+	return tc.call(context.Background(), c)
+}
+
+// checkDecoded reports the fields of a decoded model, or of every item of a
+// decoded list, that are required by the model but hold their zero value.
+// Fields tagged omitempty, pointers and booleans may legitimately be zero.
+func checkDecoded(result interface{}) error {
+	v := reflect.ValueOf(result)
+	if v.Kind() == reflect.Ptr {
+		if v.IsNil() {
+			return fmt.Errorf("no %s", v.Type().Elem().Name())
+		}
+		v = v.Elem()
+	}
+
+	if v.Kind() == reflect.Slice {
+		if v.Len() == 0 {
+			return fmt.Errorf("an empty list of %s", v.Type().Elem().Name())
+		}
+		for i := range v.Len() {
+			if err := checkDecoded(v.Index(i).Interface()); err != nil {
+				return fmt.Errorf("item %d: %w", i, err)
+			}
+		}
+		return nil
+	}
+
+	var zero []string
+	for i := range v.NumField() {
+		field := v.Type().Field(i)
+		name, opts, _ := strings.Cut(field.Tag.Get("json"), ",")
+		if name == "" || name == "-" || strings.Contains(opts, "omitempty") {
+			continue
+		}
+		if kind := field.Type.Kind(); kind == reflect.Ptr || kind == reflect.Bool {
+			continue
+		}
+		if v.Field(i).IsZero() {
+			zero = append(zero, name)
+		}
+	}
+	if len(zero) > 0 {
+		return fmt.Errorf("%s with zero-valued %s", v.Type().Name(), strings.Join(zero, ", "))
+	}
+	return nil
+}
+
+func contractCaseIndex(t *testing.T, fixture string) int {
+	t.Helper()
+
+	for i, tc := range contractCases {
+		if tc.fixture == fixture {
+			return i
+		}
+	}
+	t.Fatalf("no contract case %q", fixture)
+	return -1
+}
@@ -0,0 +1,196 @@
+// Copyright 2025 InferaDB
+// SPDX-License-Identifier: Apache-2.0
+
+package client
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+)
+
+// Sentinel errors classifying API failures. An *APIError matches the sentinel
+// for its status code through errors.Is, including when wrapped by the
+// "failed to ...: %w" context every client method adds:
+//
+//	if errors.Is(err, client.ErrNotFound) { ... }
+//
+// The Is* helpers below are shorthands for the same checks.
+var (
+	// ErrNotFound matches 404 Not Found responses.
+	ErrNotFound = errors.New("not found")
+
+	// ErrUnauthorized matches 401 Unauthorized responses.
+	ErrUnauthorized = errors.New("unauthorized")
+
+	// ErrForbidden matches 403 Forbidden responses.
+	ErrForbidden = errors.New("forbidden")
+
+	// ErrConflict matches 409 Conflict responses.
+	ErrConflict = errors.New("conflict")
+
+	// ErrPreconditionFailed matches 412 Precondition Failed responses, returned
+	// when a conditional update's If-Match no longer matches the object.
+	ErrPreconditionFailed = errors.New("precondition failed")
+
+	// ErrRateLimited matches 429 Too Many Requests responses.
+	ErrRateLimited = errors.New("rate limited")
+
+	// ErrValidation matches 400 Bad Request and 422 Unprocessable Entity responses.
+	ErrValidation = errors.New("validation failed")
+
+	// ErrServerError matches 5xx responses.
+	ErrServerError = errors.New("server error")
+)
+
+// APIError represents an error response from the API.
+type APIError struct {
+	StatusCode int
+	Message    string
+	Code       string
+}
+
+func (e *APIError) Error() string {
+	if e.Code != "" {
+		return fmt.Sprintf("InferaDB API error (%d): %s - %s", e.StatusCode, e.Code, e.Message)
+	}
+	return fmt.Sprintf("InferaDB API error (%d): %s", e.StatusCode, e.Message)
+}
+
+// Is reports whether the error matches one of the sentinel errors above.
+func (e *APIError) Is(target error) bool {
+	switch target {
+	case ErrNotFound:
+		return e.StatusCode == http.StatusNotFound
+	case ErrUnauthorized:
+		return e.StatusCode == http.StatusUnauthorized
+	case ErrForbidden:
+		return e.StatusCode == http.StatusForbidden
+	case ErrConflict:
+		return e.StatusCode == http.StatusConflict
+	case ErrPreconditionFailed:
+		return e.StatusCode == http.StatusPreconditionFailed
+	case ErrRateLimited:
+		return e.StatusCode == http.StatusTooManyRequests
+	case ErrValidation:
+		return e.StatusCode == http.StatusBadRequest || e.StatusCode == http.StatusUnprocessableEntity
+	case ErrServerError:
+		return e.StatusCode >= http.StatusInternalServerError
+	}
+	return false
+}
+
+// newAPIError builds the APIError for an error response.
+func newAPIError(statusCode int, body []byte) *APIError {
+	var errResp errorResponse
+	if err := json.Unmarshal(body, &errResp); err != nil {
+		return &APIError{
+			StatusCode: statusCode,
+			Message:    string(body),
+		}
+	}
+	msg := errResp.Message
+	if msg == "" {
+		msg = errResp.Error
+	}
+	return &APIError{
+		StatusCode: statusCode,
+		Message:    msg,
+		Code:       errResp.Code,
+	}
+}
+
+// AuthError is returned when the API rejects the client's credentials with
+// 401 Unauthorized and they could not be refreshed. The client remembers the
+// failure: every later request fails immediately with a copy of it whose
+// Repeated field is set, instead of being sent with the same credentials.
+// Err and Hint of the copies are those of the first failure, so callers can
+// report every one of them as the same error.
+//
+// AuthError wraps the original failure, so IsUnauthorized reports true.
+type AuthError struct {
+	// Err is the failure that rejected the credentials.
+	Err error
+
+	// Hint tells the user how to re-authenticate. It is set from Config.ReauthHint.
+	Hint string
+
+	// Repeated is set on requests that were not sent because an earlier
+	// request already failed authentication.
+	Repeated bool
+}
+
+func (e *AuthError) Error() string {
+	if e.Repeated {
+		return "request not sent: InferaDB rejected the provider credentials earlier in this run"
+	}
+	msg := "authentication failed: " + e.Err.Error()
+	if e.Hint != "" {
+		msg += ". " + e.Hint
+	}
+	return msg
+}
+
+func (e *AuthError) Unwrap() error {
+	return e.Err
+}
+
+// repeated returns the error of a request not sent because of e.
+func (e *AuthError) repeated() *AuthError {
+	r := *e
+	r.Repeated = true
+	return &r
+}
+
+// IsNotFound returns true if the error is a 404 Not Found error.
+func (e *APIError) IsNotFound() bool {
+	return e.StatusCode == http.StatusNotFound
+}
+
+// IsNotFound reports whether err, or any error it wraps, is a 404 Not Found API error.
+func IsNotFound(err error) bool {
+	return errors.Is(err, ErrNotFound)
+}
+
+// IsUnauthorized reports whether err, or any error it wraps, is a 401 Unauthorized API error.
+func IsUnauthorized(err error) bool {
+	return errors.Is(err, ErrUnauthorized)
+}
+
+// IsForbidden reports whether err, or any error it wraps, is a 403 Forbidden API error.
+func IsForbidden(err error) bool {
+	return errors.Is(err, ErrForbidden)
+}
+
+// IsConflict reports whether err, or any error it wraps, is a 409 Conflict API error.
+func IsConflict(err error) bool {
+	return errors.Is(err, ErrConflict)
+}
+
+// IsPreconditionFailed reports whether err, or any error it wraps, is a 412 Precondition Failed API error.
+func IsPreconditionFailed(err error) bool {
+	return errors.Is(err, ErrPreconditionFailed)
+}
+
+// IsRateLimited reports whether err, or any error it wraps, is a 429 Too Many Requests API error.
+func IsRateLimited(err error) bool {
+	return errors.Is(err, ErrRateLimited)
+}
+
+// IsValidation reports whether err, or any error it wraps, is a 400 or 422 API error.
+func IsValidation(err error) bool {
+	return errors.Is(err, ErrValidation)
+}
+
+// IsServerError reports whether err, or any error it wraps, is a 5xx API error.
+func IsServerError(err error) bool {
+	return errors.Is(err, ErrServerError)
+}
+
+// errorResponse is the JSON structure for API error responses.
+type errorResponse struct {
+	Error   string `json:"error"`
+	Code    string `json:"code,omitempty"`
+	Message string `json:"message,omitempty"`
+}
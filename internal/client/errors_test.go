@@ -0,0 +1,73 @@
+// Copyright 2025 InferaDB
+// SPDX-License-Identifier: Apache-2.0
+
+package client
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestErrorPredicatesMatchWrappedErrors(t *testing.T) {
+	predicates := map[string]func(error) bool{
+		"IsNotFound":           IsNotFound,
+		"IsUnauthorized":       IsUnauthorized,
+		"IsForbidden":          IsForbidden,
+		"IsConflict":           IsConflict,
+		"IsRateLimited":        IsRateLimited,
+		"IsPreconditionFailed": IsPreconditionFailed,
+		"IsValidation":         IsValidation,
+		"IsServerError":        IsServerError,
+	}
+
+	tests := []struct {
+		status int
+		want   string
+	}{
+		{http.StatusNotFound, "IsNotFound"},
+		{http.StatusUnauthorized, "IsUnauthorized"},
+		{http.StatusForbidden, "IsForbidden"},
+		{http.StatusConflict, "IsConflict"},
+		{http.StatusTooManyRequests, "IsRateLimited"},
+		{http.StatusPreconditionFailed, "IsPreconditionFailed"},
+		{http.StatusBadRequest, "IsValidation"},
+		{http.StatusUnprocessableEntity, "IsValidation"},
+		{http.StatusInternalServerError, "IsServerError"},
+		{http.StatusServiceUnavailable, "IsServerError"},
+	}
+
+	for _, tt := range tests {
+		err := fmt.Errorf("failed to get vault: %w", &APIError{StatusCode: tt.status})
+		for name, predicate := range predicates {
+			if got, want := predicate(err), name == tt.want; got != want {
+				t.Errorf("%s(%d) = %v, want %v", name, tt.status, got, want)
+			}
+		}
+	}
+}
+
+func TestClientMethodErrorsAreClassified(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+		_, _ = w.Write([]byte(`{"error":"vault not found","code":"NOT_FOUND"}`))
+	}))
+	defer srv.Close()
+
+	c := New(Config{Endpoint: srv.URL})
+	_, err := c.GetVault(context.Background(), "1", "2")
+	if !IsNotFound(err) {
+		t.Fatalf("IsNotFound(%v) = false, want true", err)
+	}
+
+	var apiErr *APIError
+	if !errors.As(err, &apiErr) {
+		t.Fatalf("errors.As(%v, *APIError) = false", err)
+	}
+	if apiErr.Code != "NOT_FOUND" || apiErr.Message != "vault not found" {
+		t.Errorf("unexpected API error: %+v", apiErr)
+	}
+}
@@ -0,0 +1,21 @@
+// Copyright 2025 InferaDB
+// SPDX-License-Identifier: Apache-2.0
+
+package client
+
+// withIfMatch sends etag in the If-Match header, so the API rejects the
+// request with 412 Precondition Failed when the object was changed since
+// etag was read, e.g. in the dashboard. An empty etag sends none, making
+// the update unconditional.
+func withIfMatch(etag string) requestOption {
+	return func(o *requestOptions) {
+		o.ifMatch = etag
+	}
+}
+
+// captureETag stores the ETag header of a successful response in etag.
+func captureETag(etag *string) requestOption {
+	return func(o *requestOptions) {
+		o.etag = etag
+	}
+}
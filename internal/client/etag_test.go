@@ -0,0 +1,57 @@
+// Copyright 2025 InferaDB
+// SPDX-License-Identifier: Apache-2.0
+
+package client
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestConditionalUpdate(t *testing.T) {
+	current := `"v1"`
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case http.MethodGet:
+			w.Header().Set("ETag", current)
+			_, _ = w.Write([]byte(`{"id":2,"name":"prod"}`))
+		case http.MethodPatch:
+			if r.Header.Get("If-Match") != current {
+				w.WriteHeader(http.StatusPreconditionFailed)
+				_, _ = w.Write([]byte(`{"error":"vault was modified","code":"PRECONDITION_FAILED"}`))
+				return
+			}
+			current = `"v2"`
+			w.Header().Set("ETag", current)
+			_, _ = w.Write([]byte(`{"vault":{"id":2,"name":"renamed"}}`))
+		}
+	}))
+	defer srv.Close()
+
+	c := New(Config{Endpoint: srv.URL})
+	ctx := context.Background()
+
+	vault, err := c.GetVault(ctx, "1", "2")
+	if err != nil {
+		t.Fatalf("GetVault: %v", err)
+	}
+	if vault.ETag != `"v1"` {
+		t.Fatalf("ETag = %q, want \"v1\"", vault.ETag)
+	}
+
+	updated, err := c.UpdateVault(ctx, "1", "2", UpdateVaultRequest{Name: "renamed", IfMatch: vault.ETag})
+	if err != nil {
+		t.Fatalf("UpdateVault: %v", err)
+	}
+	if updated.ETag != `"v2"` {
+		t.Errorf("updated ETag = %q, want \"v2\"", updated.ETag)
+	}
+
+	// The stale ETag no longer matches.
+	_, err = c.UpdateVault(ctx, "1", "2", UpdateVaultRequest{Name: "again", IfMatch: vault.ETag})
+	if !IsPreconditionFailed(err) {
+		t.Errorf("UpdateVault with stale ETag = %v, want precondition failed", err)
+	}
+}
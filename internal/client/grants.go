@@ -11,7 +11,7 @@ import (
 // CreateVaultUserGrant grants a user access to a vault.
 func (c *Client) CreateVaultUserGrant(ctx context.Context, orgID, vaultID string, req CreateVaultUserGrantRequest) (*VaultUserGrant, error) {
 	var grant VaultUserGrant
-	if err := c.post(ctx, fmt.Sprintf("/v1/organizations/%s/vaults/%s/user-grants", orgID, vaultID), req, &grant); err != nil {
+	if err := c.create(ctx, fmt.Sprintf("/v1/organizations/%s/vaults/%s/user-grants", orgID, vaultID), req, &grant, captureETag(&grant.ETag)); err != nil {
 		return nil, fmt.Errorf("failed to create user grant: %w", err)
 	}
 	return &grant, nil
@@ -20,7 +20,7 @@ func (c *Client) CreateVaultUserGrant(ctx context.Context, orgID, vaultID string
 // GetVaultUserGrant retrieves a user grant by ID.
 func (c *Client) GetVaultUserGrant(ctx context.Context, orgID, vaultID, grantID string) (*VaultUserGrant, error) {
 	var grant VaultUserGrant
-	if err := c.get(ctx, fmt.Sprintf("/v1/organizations/%s/vaults/%s/user-grants/%s", orgID, vaultID, grantID), &grant); err != nil {
+	if err := c.get(ctx, fmt.Sprintf("/v1/organizations/%s/vaults/%s/user-grants/%s", orgID, vaultID, grantID), &grant, captureETag(&grant.ETag)); err != nil {
 		return nil, fmt.Errorf("failed to get user grant: %w", err)
 	}
 	return &grant, nil
@@ -29,7 +29,7 @@ func (c *Client) GetVaultUserGrant(ctx context.Context, orgID, vaultID, grantID
 // UpdateVaultUserGrant updates a user grant's role.
 func (c *Client) UpdateVaultUserGrant(ctx context.Context, orgID, vaultID, grantID string, req UpdateVaultUserGrantRequest) (*VaultUserGrant, error) {
 	var grant VaultUserGrant
-	if err := c.patch(ctx, fmt.Sprintf("/v1/organizations/%s/vaults/%s/user-grants/%s", orgID, vaultID, grantID), req, &grant); err != nil {
+	if err := c.patch(ctx, fmt.Sprintf("/v1/organizations/%s/vaults/%s/user-grants/%s", orgID, vaultID, grantID), req, &grant, withIfMatch(req.IfMatch), captureETag(&grant.ETag)); err != nil {
 		return nil, fmt.Errorf("failed to update user grant: %w", err)
 	}
 	return &grant, nil
@@ -43,10 +43,10 @@ func (c *Client) DeleteVaultUserGrant(ctx context.Context, orgID, vaultID, grant
 	return nil
 }
 
-// ListVaultUserGrants lists all user grants for a vault.
+// ListVaultUserGrants lists all user grants for a vault, fetching every page.
 func (c *Client) ListVaultUserGrants(ctx context.Context, orgID, vaultID string) ([]VaultUserGrant, error) {
-	var grants []VaultUserGrant
-	if err := c.get(ctx, fmt.Sprintf("/v1/organizations/%s/vaults/%s/user-grants", orgID, vaultID), &grants); err != nil {
+	grants, err := listAll[VaultUserGrant](ctx, c, fmt.Sprintf("/v1/organizations/%s/vaults/%s/user-grants", orgID, vaultID), "grants")
+	if err != nil {
 		return nil, fmt.Errorf("failed to list user grants: %w", err)
 	}
 	return grants, nil
@@ -55,7 +55,7 @@ func (c *Client) ListVaultUserGrants(ctx context.Context, orgID, vaultID string)
 // CreateVaultTeamGrant grants a team access to a vault.
 func (c *Client) CreateVaultTeamGrant(ctx context.Context, orgID, vaultID string, req CreateVaultTeamGrantRequest) (*VaultTeamGrant, error) {
 	var grant VaultTeamGrant
-	if err := c.post(ctx, fmt.Sprintf("/v1/organizations/%s/vaults/%s/team-grants", orgID, vaultID), req, &grant); err != nil {
+	if err := c.create(ctx, fmt.Sprintf("/v1/organizations/%s/vaults/%s/team-grants", orgID, vaultID), req, &grant, captureETag(&grant.ETag)); err != nil {
 		return nil, fmt.Errorf("failed to create team grant: %w", err)
 	}
 	return &grant, nil
@@ -64,7 +64,7 @@ func (c *Client) CreateVaultTeamGrant(ctx context.Context, orgID, vaultID string
 // GetVaultTeamGrant retrieves a team grant by ID.
 func (c *Client) GetVaultTeamGrant(ctx context.Context, orgID, vaultID, grantID string) (*VaultTeamGrant, error) {
 	var grant VaultTeamGrant
-	if err := c.get(ctx, fmt.Sprintf("/v1/organizations/%s/vaults/%s/team-grants/%s", orgID, vaultID, grantID), &grant); err != nil {
+	if err := c.get(ctx, fmt.Sprintf("/v1/organizations/%s/vaults/%s/team-grants/%s", orgID, vaultID, grantID), &grant, captureETag(&grant.ETag)); err != nil {
 		return nil, fmt.Errorf("failed to get team grant: %w", err)
 	}
 	return &grant, nil
@@ -73,7 +73,7 @@ func (c *Client) GetVaultTeamGrant(ctx context.Context, orgID, vaultID, grantID
 // UpdateVaultTeamGrant updates a team grant's role.
 func (c *Client) UpdateVaultTeamGrant(ctx context.Context, orgID, vaultID, grantID string, req UpdateVaultTeamGrantRequest) (*VaultTeamGrant, error) {
 	var grant VaultTeamGrant
-	if err := c.patch(ctx, fmt.Sprintf("/v1/organizations/%s/vaults/%s/team-grants/%s", orgID, vaultID, grantID), req, &grant); err != nil {
+	if err := c.patch(ctx, fmt.Sprintf("/v1/organizations/%s/vaults/%s/team-grants/%s", orgID, vaultID, grantID), req, &grant, withIfMatch(req.IfMatch), captureETag(&grant.ETag)); err != nil {
 		return nil, fmt.Errorf("failed to update team grant: %w", err)
 	}
 	return &grant, nil
@@ -87,10 +87,10 @@ func (c *Client) DeleteVaultTeamGrant(ctx context.Context, orgID, vaultID, grant
 	return nil
 }
 
-// ListVaultTeamGrants lists all team grants for a vault.
+// ListVaultTeamGrants lists all team grants for a vault, fetching every page.
 func (c *Client) ListVaultTeamGrants(ctx context.Context, orgID, vaultID string) ([]VaultTeamGrant, error) {
-	var grants []VaultTeamGrant
-	if err := c.get(ctx, fmt.Sprintf("/v1/organizations/%s/vaults/%s/team-grants", orgID, vaultID), &grants); err != nil {
+	grants, err := listAll[VaultTeamGrant](ctx, c, fmt.Sprintf("/v1/organizations/%s/vaults/%s/team-grants", orgID, vaultID), "grants")
+	if err != nil {
 		return nil, fmt.Errorf("failed to list team grants: %w", err)
 	}
 	return grants, nil
@@ -0,0 +1,41 @@
+// Copyright 2025 InferaDB
+// SPDX-License-Identifier: Apache-2.0
+
+package client
+
+import (
+	"context"
+	"crypto/rand"
+	"fmt"
+	"net/http"
+)
+
+// IdempotencyKeyHeader carries the key that lets the API recognize a create
+// request it has already committed.
+const IdempotencyKeyHeader = "Idempotency-Key"
+
+// newIdempotencyKey returns a random UUID (version 4).
+func newIdempotencyKey() string {
+	var b [16]byte
+	_, _ = rand.Read(b[:])
+	b[6] = b[6]&0x0f | 0x40
+	b[8] = b[8]&0x3f | 0x80
+	return fmt.Sprintf("%x-%x-%x-%x-%x", b[0:4], b[4:6], b[6:8], b[8:10], b[10:16])
+}
+
+// withIdempotencyKey sends key in the Idempotency-Key header of every
+// attempt, which makes the request safe to retry.
+func withIdempotencyKey(key string) requestOption {
+	return func(o *requestOptions) {
+		o.idempotencyKey = key
+		o.idempotent = true
+	}
+}
+
+// create POSTs a create request under a new random idempotency key. The key
+// is repeated by the retries of this call only, so a retry after a lost
+// response is recognized as the same request, while separate creates, even
+// with equal bodies, are never mistaken for each other.
+func (c *Client) create(ctx context.Context, path string, body interface{}, result interface{}, opts ...requestOption) error {
+	return c.doRequest(ctx, http.MethodPost, path, body, result, append(opts, withIdempotencyKey(newIdempotencyKey()))...)
+}
@@ -0,0 +1,138 @@
+// Copyright 2025 InferaDB
+// SPDX-License-Identifier: Apache-2.0
+
+package client
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestCreateSendsStableIdempotencyKey(t *testing.T) {
+	var (
+		mu   sync.Mutex
+		keys []string
+	)
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		keys = append(keys, r.Header.Get(IdempotencyKeyHeader))
+		attempt := len(keys)
+		mu.Unlock()
+
+		// The first attempt is committed but its response is lost.
+		if attempt == 1 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		w.WriteHeader(http.StatusCreated)
+		_, _ = w.Write([]byte(`{"vault":{"id":7,"name":"prod"}}`))
+	}))
+	defer srv.Close()
+
+	c := New(Config{Endpoint: srv.URL, RetryMaxWait: 1})
+	vault, err := c.CreateVault(context.Background(), "1", CreateVaultRequest{Name: "prod"})
+	if err != nil {
+		t.Fatalf("CreateVault: %v", err)
+	}
+	if vault.ID != "7" {
+		t.Errorf("vault ID = %q, want 7", vault.ID)
+	}
+	if len(keys) != 2 || keys[0] == "" || keys[0] != keys[1] {
+		t.Errorf("idempotency keys = %q, want the same non-empty key on both attempts", keys)
+	}
+}
+
+// Separate creates are separate objects, even when the requests are equal,
+// so the key must not be derived from the request.
+func TestCreateUsesNewKeyPerCall(t *testing.T) {
+	var (
+		mu   sync.Mutex
+		keys []string
+	)
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		keys = append(keys, r.Header.Get(IdempotencyKeyHeader))
+		mu.Unlock()
+		w.WriteHeader(http.StatusCreated)
+		_, _ = w.Write([]byte(`{"team":{"id":7,"name":"ops"}}`))
+	}))
+	defer srv.Close()
+
+	c := New(Config{Endpoint: srv.URL, CacheTTL: -1})
+	ctx := context.Background()
+	for i := 0; i < 2; i++ {
+		if _, err := c.CreateTeam(ctx, "1", CreateTeamRequest{Name: "ops"}); err != nil {
+			t.Fatalf("CreateTeam: %v", err)
+		}
+	}
+	if len(keys) != 2 || keys[0] == "" || keys[0] == keys[1] {
+		t.Errorf("idempotency keys = %q, want a different key for each create", keys)
+	}
+}
+
+func TestCreateRetriesTimedOutAttempt(t *testing.T) {
+	var (
+		mu      sync.Mutex
+		keys    []string
+		created bool
+	)
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		keys = append(keys, r.Header.Get(IdempotencyKeyHeader))
+		first := !created
+		created = true
+		mu.Unlock()
+
+		if first {
+			// The vault is committed but the response never arrives.
+			_, _ = io.Copy(io.Discard, r.Body)
+			select {
+			case <-r.Context().Done():
+			case <-time.After(5 * time.Second):
+			}
+			return
+		}
+		w.WriteHeader(http.StatusCreated)
+		_, _ = w.Write([]byte(`{"vault":{"id":7,"name":"prod"}}`))
+	}))
+	defer srv.Close()
+
+	c := New(Config{Endpoint: srv.URL, Timeout: 50 * time.Millisecond, RetryMaxWait: time.Millisecond})
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	vault, err := c.CreateVault(ctx, "1", CreateVaultRequest{Name: "prod"})
+	if err != nil {
+		t.Fatalf("CreateVault: %v", err)
+	}
+	if vault.ID != "7" {
+		t.Errorf("vault ID = %q, want 7", vault.ID)
+	}
+	if len(keys) != 2 || keys[0] != keys[1] {
+		t.Errorf("idempotency keys = %q, want the same key on both attempts", keys)
+	}
+}
+
+func TestCreateReturnsConflicts(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusConflict)
+		_, _ = w.Write([]byte(`{"error":"name taken","code":"ALREADY_EXISTS"}`))
+	}))
+	defer srv.Close()
+
+	c := New(Config{Endpoint: srv.URL})
+	if _, err := c.CreateTeam(context.Background(), "1", CreateTeamRequest{Name: "ops"}); !IsConflict(err) {
+		t.Fatalf("CreateTeam error = %v, want conflict", err)
+	}
+}
+
+func TestNewIdempotencyKeyIsUUID(t *testing.T) {
+	key := newIdempotencyKey()
+	if len(key) != 36 || key[14] != '4' || key == newIdempotencyKey() {
+		t.Errorf("newIdempotencyKey() = %q, want a random version 4 UUID", key)
+	}
+}
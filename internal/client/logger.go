@@ -0,0 +1,34 @@
+// Copyright 2025 InferaDB
+// SPDX-License-Identifier: Apache-2.0
+
+package client
+
+import (
+	"context"
+	"net/http"
+	"time"
+)
+
+// Logger receives a record of every attempt of every request. Credentials
+// and secret fields are redacted before LogRequest is called.
+type Logger interface {
+	LogRequest(ctx context.Context, entry RequestLog)
+}
+
+// RequestLog describes one attempt of a request.
+type RequestLog struct {
+	Method  string
+	Path    string
+	Attempt int // Zero for the first attempt
+
+	RequestHeaders http.Header
+	RequestBody    string
+
+	// StatusCode and the response fields are zero when Err is set.
+	StatusCode      int
+	ResponseHeaders http.Header
+	ResponseBody    string
+
+	Duration time.Duration
+	Err      error
+}
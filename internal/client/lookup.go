@@ -0,0 +1,98 @@
+// Copyright 2025 InferaDB
+// SPDX-License-Identifier: Apache-2.0
+
+package client
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+)
+
+// lookup finds the object with the given ID in the listing of path. The
+// listing is fetched once and cached like GET responses, so looking up many
+// objects of one collection costs a single paged list instead of one GET
+// each. Listed objects carry no ETag. A missing object yields the same 404
+// APIError as the Get method would.
+func lookup[T any](ctx context.Context, c *Client, path, key, id string, idOf func(*T) string) (*T, error) {
+	cached, err := c.lists.get(ctx, path, func() (interface{}, error) {
+		return listAll[T](ctx, c, path, key)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	items, _ := cached.([]T)
+	for i := range items {
+		if idOf(&items[i]) == id {
+			item := items[i]
+			return &item, nil
+		}
+	}
+	return nil, &APIError{StatusCode: http.StatusNotFound, Message: fmt.Sprintf("%s not found in %s", id, path)}
+}
+
+// LookupVaultUserGrant returns a user grant from the cached list of the vault's user grants.
+func (c *Client) LookupVaultUserGrant(ctx context.Context, orgID, vaultID, grantID string) (*VaultUserGrant, error) {
+	if c.lists == nil {
+		return c.GetVaultUserGrant(ctx, orgID, vaultID, grantID)
+	}
+	grant, err := lookup(ctx, c, fmt.Sprintf("/v1/organizations/%s/vaults/%s/user-grants", orgID, vaultID), "grants", grantID,
+		func(g *VaultUserGrant) string { return g.ID })
+	if err != nil {
+		return nil, fmt.Errorf("failed to get user grant: %w", err)
+	}
+	return grant, nil
+}
+
+// LookupVaultTeamGrant returns a team grant from the cached list of the vault's team grants.
+func (c *Client) LookupVaultTeamGrant(ctx context.Context, orgID, vaultID, grantID string) (*VaultTeamGrant, error) {
+	if c.lists == nil {
+		return c.GetVaultTeamGrant(ctx, orgID, vaultID, grantID)
+	}
+	grant, err := lookup(ctx, c, fmt.Sprintf("/v1/organizations/%s/vaults/%s/team-grants", orgID, vaultID), "grants", grantID,
+		func(g *VaultTeamGrant) string { return g.ID })
+	if err != nil {
+		return nil, fmt.Errorf("failed to get team grant: %w", err)
+	}
+	return grant, nil
+}
+
+// LookupTeamMember returns a team member from the cached list of the team's members.
+func (c *Client) LookupTeamMember(ctx context.Context, orgID, teamID, memberID string) (*TeamMember, error) {
+	if c.lists == nil {
+		return c.GetTeamMember(ctx, orgID, teamID, memberID)
+	}
+	member, err := lookup(ctx, c, fmt.Sprintf("/v1/organizations/%s/teams/%s/members", orgID, teamID), "members", memberID,
+		func(m *TeamMember) string { return m.ID })
+	if err != nil {
+		return nil, fmt.Errorf("failed to get team member: %w", err)
+	}
+	return member, nil
+}
+
+// LookupCertificate returns a certificate from the cached list of the client's certificates.
+func (c *Client) LookupCertificate(ctx context.Context, orgID, clientID, certID string) (*ClientCertificate, error) {
+	if c.lists == nil {
+		return c.GetCertificate(ctx, orgID, clientID, certID)
+	}
+	cert, err := lookup(ctx, c, fmt.Sprintf("/v1/organizations/%s/clients/%s/certificates", orgID, clientID), "certificates", certID,
+		func(cert *ClientCertificate) string { return cert.ID })
+	if err != nil {
+		return nil, fmt.Errorf("failed to get certificate: %w", err)
+	}
+	return cert, nil
+}
+
+// LookupClient returns a client from the cached list of the organization's clients.
+func (c *Client) LookupClient(ctx context.Context, orgID, clientID string) (*InferaClient, error) {
+	if c.lists == nil {
+		return c.GetClient(ctx, orgID, clientID)
+	}
+	client, err := lookup(ctx, c, fmt.Sprintf("/v1/organizations/%s/clients", orgID), "clients", clientID,
+		func(client *InferaClient) string { return client.ID.String() })
+	if err != nil {
+		return nil, fmt.Errorf("failed to get client: %w", err)
+	}
+	return client, nil
+}
@@ -0,0 +1,86 @@
+// Copyright 2025 InferaDB
+// SPDX-License-Identifier: Apache-2.0
+
+package client
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+)
+
+func TestLookupServesReadsFromOneList(t *testing.T) {
+	var lists, gets atomic.Int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == http.MethodDelete:
+			w.WriteHeader(http.StatusNoContent)
+		case r.URL.Path == "/v1/organizations/1/vaults/2/user-grants":
+			lists.Add(1)
+			_, _ = w.Write([]byte(`{"grants":[{"id":"10","user_id":"u1","role":"reader"},{"id":"11","user_id":"u2","role":"writer"}],"pagination":{"has_more":false}}`))
+		default:
+			gets.Add(1)
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer srv.Close()
+
+	c := New(Config{Endpoint: srv.URL})
+	ctx := context.Background()
+
+	for _, id := range []string{"10", "11", "10"} {
+		grant, err := c.LookupVaultUserGrant(ctx, "1", "2", id)
+		if err != nil {
+			t.Fatalf("LookupVaultUserGrant(%s): %v", id, err)
+		}
+		if grant.ID != id {
+			t.Errorf("id = %q, want %q", grant.ID, id)
+		}
+	}
+	if _, err := c.LookupVaultUserGrant(ctx, "1", "2", "12"); !IsNotFound(err) {
+		t.Errorf("missing grant: err = %v, want not found", err)
+	}
+	if got := lists.Load(); got != 1 {
+		t.Errorf("list calls = %d, want 1", got)
+	}
+
+	if err := c.DeleteVaultUserGrant(ctx, "1", "2", "10"); err != nil {
+		t.Fatalf("DeleteVaultUserGrant: %v", err)
+	}
+	if _, err := c.LookupVaultUserGrant(ctx, "1", "2", "11"); err != nil {
+		t.Fatalf("LookupVaultUserGrant after delete: %v", err)
+	}
+	if got := lists.Load(); got != 2 {
+		t.Errorf("list calls after delete = %d, want 2", got)
+	}
+	if got := gets.Load(); got != 0 {
+		t.Errorf("per-item GETs = %d, want 0", got)
+	}
+}
+
+func TestLookupWithoutCacheUsesGet(t *testing.T) {
+	var gets atomic.Int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/v1/organizations/1/teams/2/members/3" {
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+		gets.Add(1)
+		w.Header().Set("ETag", `"v1"`)
+		_, _ = w.Write([]byte(`{"member":{"id":"3","team_id":"2","user_id":"u1","role":"member"}}`))
+	}))
+	defer srv.Close()
+
+	c := New(Config{Endpoint: srv.URL, CacheTTL: -1})
+	member, err := c.LookupTeamMember(context.Background(), "1", "2", "3")
+	if err != nil {
+		t.Fatalf("LookupTeamMember: %v", err)
+	}
+	if member.ETag != `"v1"` {
+		t.Errorf("etag = %q, want %q", member.ETag, `"v1"`)
+	}
+	if got := gets.Load(); got != 1 {
+		t.Errorf("GETs = %d, want 1", got)
+	}
+}
@@ -4,7 +4,9 @@
 package client
 
 import (
+	"bytes"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"strconv"
 )
@@ -13,7 +15,9 @@ import (
 // The InferaDB API returns IDs as numbers, but we want to use them as strings in Terraform.
 type SnowflakeID string
 
-// UnmarshalJSON implements json.Unmarshaler for SnowflakeID.
+// UnmarshalJSON implements json.Unmarshaler for SnowflakeID. Numbers are kept
+// digit for digit, so IDs beyond the int64 range up to the uint64 maximum
+// are not rounded or rejected.
 func (s *SnowflakeID) UnmarshalJSON(data []byte) error {
 	// Try unmarshaling as string first
 	var str string
@@ -22,11 +26,15 @@ func (s *SnowflakeID) UnmarshalJSON(data []byte) error {
 		return nil
 	}
 
-	// Try unmarshaling as number
-	var num int64
-	if err := json.Unmarshal(data, &num); err == nil {
-		*s = SnowflakeID(strconv.FormatInt(num, 10))
-		return nil
+	// Otherwise decode the number losslessly
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.UseNumber()
+	var num json.Number
+	if err := decoder.Decode(&num); err == nil {
+		if id, err := ParseSnowflakeID(num.String()); err == nil {
+			*s = id
+			return nil
+		}
 	}
 
 	return fmt.Errorf("cannot unmarshal %s into SnowflakeID", string(data))
@@ -37,6 +45,26 @@ func (s SnowflakeID) String() string {
 	return string(s)
 }
 
+// ParseSnowflakeID validates s as the canonical decimal form of an unsigned
+// 64-bit snowflake ID: digits only, no sign and no leading zeros.
+func ParseSnowflakeID(s string) (SnowflakeID, error) {
+	if s == "" {
+		return "", errors.New("snowflake ID is empty")
+	}
+	for _, r := range s {
+		if r < '0' || r > '9' {
+			return "", fmt.Errorf("snowflake ID %q must contain only decimal digits", s)
+		}
+	}
+	if len(s) > 1 && s[0] == '0' {
+		return "", fmt.Errorf("snowflake ID %q must not have leading zeros", s)
+	}
+	if _, err := strconv.ParseUint(s, 10, 64); err != nil {
+		return "", fmt.Errorf("snowflake ID %q does not fit in 64 bits", s)
+	}
+	return SnowflakeID(s), nil
+}
+
 // Organization represents an InferaDB organization.
 type Organization struct {
 	ID          SnowflakeID `json:"id"`
@@ -47,6 +75,7 @@ type Organization struct {
 	DeletedAt   *string     `json:"deleted_at,omitempty"`
 	SuspendedAt *string     `json:"suspended_at,omitempty"`
 	Role        string      `json:"role,omitempty"` // User's role in this organization
+	ETag        string      `json:"-"`              // From the ETag response header
 }
 
 // OrganizationResponse wraps an organization in API responses.
@@ -77,10 +106,18 @@ type CreateOrganizationRequest struct {
 
 // UpdateOrganizationRequest is the request body for updating an organization.
 type UpdateOrganizationRequest struct {
-	Name string `json:"name,omitempty"`
-	Tier string `json:"tier,omitempty"`
+	Name    string `json:"name,omitempty"`
+	Tier    string `json:"tier,omitempty"`
+	IfMatch string `json:"-"` // Sent as If-Match; see ErrPreconditionFailed
 }
 
+// Vault sync statuses reported in Vault.SyncStatus.
+const (
+	VaultSyncPending = "pending"
+	VaultSyncSynced  = "synced"
+	VaultSyncFailed  = "failed"
+)
+
 // Vault represents an InferaDB vault.
 type Vault struct {
 	ID             SnowflakeID `json:"id"`
@@ -92,6 +129,7 @@ type Vault struct {
 	CreatedAt      string      `json:"created_at"`
 	UpdatedAt      string      `json:"updated_at,omitempty"`
 	DeletedAt      *string     `json:"deleted_at,omitempty"`
+	ETag           string      `json:"-"` // From the ETag response header
 }
 
 // VaultResponse wraps a vault in API responses.
@@ -115,6 +153,7 @@ type CreateVaultRequest struct {
 type UpdateVaultRequest struct {
 	Name        string `json:"name,omitempty"`
 	Description string `json:"description,omitempty"`
+	IfMatch     string `json:"-"` // Sent as If-Match; see ErrPreconditionFailed
 }
 
 // InferaClient represents an InferaDB client (backend service identity).
@@ -182,6 +221,7 @@ type Team struct {
 	CreatedAt      string      `json:"created_at"`
 	UpdatedAt      string      `json:"updated_at,omitempty"`
 	DeletedAt      *string     `json:"deleted_at,omitempty"`
+	ETag           string      `json:"-"` // From the ETag response header
 }
 
 // TeamResponse wraps a team in API responses.
@@ -205,6 +245,7 @@ type CreateTeamRequest struct {
 type UpdateTeamRequest struct {
 	Name        string `json:"name,omitempty"`
 	Description string `json:"description,omitempty"`
+	IfMatch     string `json:"-"` // Sent as If-Match; see ErrPreconditionFailed
 }
 
 // TeamMember represents a team membership.
@@ -214,6 +255,7 @@ type TeamMember struct {
 	UserID    string `json:"user_id"`
 	Role      string `json:"role"`
 	CreatedAt string `json:"created_at"`
+	ETag      string `json:"-"` // From the ETag response header
 }
 
 // AddTeamMemberRequest is the request body for adding a team member.
@@ -224,7 +266,8 @@ type AddTeamMemberRequest struct {
 
 // UpdateTeamMemberRequest is the request body for updating a team member.
 type UpdateTeamMemberRequest struct {
-	Role string `json:"role"`
+	Role    string `json:"role"`
+	IfMatch string `json:"-"` // Sent as If-Match; see ErrPreconditionFailed
 }
 
 // VaultUserGrant represents a user's access grant to a vault.
@@ -235,6 +278,7 @@ type VaultUserGrant struct {
 	Role            string `json:"role"`
 	GrantedAt       string `json:"granted_at"`
 	GrantedByUserID string `json:"granted_by_user_id"`
+	ETag            string `json:"-"` // From the ETag response header
 }
 
 // CreateVaultUserGrantRequest is the request body for creating a user grant.
@@ -245,7 +289,8 @@ type CreateVaultUserGrantRequest struct {
 
 // UpdateVaultUserGrantRequest is the request body for updating a user grant.
 type UpdateVaultUserGrantRequest struct {
-	Role string `json:"role"`
+	Role    string `json:"role"`
+	IfMatch string `json:"-"` // Sent as If-Match; see ErrPreconditionFailed
 }
 
 // VaultTeamGrant represents a team's access grant to a vault.
@@ -256,6 +301,7 @@ type VaultTeamGrant struct {
 	Role            string `json:"role"`
 	GrantedAt       string `json:"granted_at"`
 	GrantedByUserID string `json:"granted_by_user_id"`
+	ETag            string `json:"-"` // From the ETag response header
 }
 
 // CreateVaultTeamGrantRequest is the request body for creating a team grant.
@@ -266,7 +312,30 @@ type CreateVaultTeamGrantRequest struct {
 
 // UpdateVaultTeamGrantRequest is the request body for updating a team grant.
 type UpdateVaultTeamGrantRequest struct {
-	Role string `json:"role"`
+	Role    string `json:"role"`
+	IfMatch string `json:"-"` // Sent as If-Match; see ErrPreconditionFailed
+}
+
+// ClientAssertionTokenRequest exchanges a client assertion JWT for an access token.
+type ClientAssertionTokenRequest struct {
+	GrantType           string `json:"grant_type"`
+	ClientAssertionType string `json:"client_assertion_type"`
+	ClientAssertion     string `json:"client_assertion"`
+}
+
+// TokenExchangeRequest exchanges an externally issued token for an access
+// token (RFC 8693).
+type TokenExchangeRequest struct {
+	GrantType        string `json:"grant_type"`
+	SubjectToken     string `json:"subject_token"`
+	SubjectTokenType string `json:"subject_token_type"`
+}
+
+// TokenResponse is returned by the token endpoint.
+type TokenResponse struct {
+	AccessToken string `json:"access_token"`
+	TokenType   string `json:"token_type"`
+	ExpiresIn   int    `json:"expires_in"` // Lifetime in seconds
 }
 
 // PaginatedResponse represents a paginated API response.
@@ -0,0 +1,53 @@
+// Copyright 2025 InferaDB
+// SPDX-License-Identifier: Apache-2.0
+
+package client
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestSnowflakeIDUnmarshalJSON(t *testing.T) {
+	tests := []struct {
+		data    string
+		want    SnowflakeID
+		wantErr bool
+	}{
+		{data: `7160832093298688`, want: "7160832093298688"},
+		{data: `"7160832093298688"`, want: "7160832093298688"},
+		// Above the int64 range, and exactly representable only as digits.
+		{data: `18446744073709551615`, want: "18446744073709551615"},
+		{data: `9007199254740993`, want: "9007199254740993"},
+		{data: `18446744073709551616`, wantErr: true},
+		{data: `-1`, wantErr: true},
+		{data: `1.5`, wantErr: true},
+		{data: `1e3`, wantErr: true},
+		{data: `true`, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		var id SnowflakeID
+		err := json.Unmarshal([]byte(tt.data), &id)
+		if (err != nil) != tt.wantErr {
+			t.Errorf("Unmarshal(%s) error = %v, wantErr %v", tt.data, err, tt.wantErr)
+			continue
+		}
+		if id != tt.want {
+			t.Errorf("Unmarshal(%s) = %q, want %q", tt.data, id, tt.want)
+		}
+	}
+}
+
+func TestParseSnowflakeID(t *testing.T) {
+	for _, valid := range []string{"0", "1", "7160832093298688", "18446744073709551615"} {
+		if _, err := ParseSnowflakeID(valid); err != nil {
+			t.Errorf("ParseSnowflakeID(%q): %v", valid, err)
+		}
+	}
+	for _, invalid := range []string{"", " 1", "+1", "-1", "0x1f", "007", "12a", "18446744073709551616"} {
+		if _, err := ParseSnowflakeID(invalid); err == nil {
+			t.Errorf("ParseSnowflakeID(%q) succeeded, want error", invalid)
+		}
+	}
+}
@@ -0,0 +1,110 @@
+// Copyright 2025 InferaDB
+// SPDX-License-Identifier: Apache-2.0
+
+package client
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"os"
+	"strings"
+)
+
+// Token exchange parameters defined by RFC 8693.
+const (
+	tokenExchangeGrantType = "urn:ietf:params:oauth:grant-type:token-exchange"
+	idTokenType            = "urn:ietf:params:oauth:token-type:id_token"
+)
+
+// IDTokenSource returns the current OIDC ID token of the workload.
+type IDTokenSource func(ctx context.Context) (string, error)
+
+// IDTokenFromFile reads the ID token from a file on every call, so tokens
+// rotated by the CI runner or orchestrator are picked up.
+func IDTokenFromFile(path string) IDTokenSource {
+	return func(context.Context) (string, error) {
+		data, err := os.ReadFile(path)
+		if err != nil {
+			return "", fmt.Errorf("failed to read ID token: %w", err)
+		}
+		token := strings.TrimSpace(string(data))
+		if token == "" {
+			return "", fmt.Errorf("ID token file %s is empty", path)
+		}
+		return token, nil
+	}
+}
+
+// IDTokenFromEnv reads the ID token from an environment variable, such as
+// the variables GitLab CI populates from id_tokens.
+func IDTokenFromEnv(name string) IDTokenSource {
+	return func(context.Context) (string, error) {
+		token := strings.TrimSpace(os.Getenv(name))
+		if token == "" {
+			return "", fmt.Errorf("environment variable %s holds no ID token", name)
+		}
+		return token, nil
+	}
+}
+
+// OIDCAuth authenticates a CI job or other workload through OIDC identity
+// federation. It exchanges the workload's ID token for a short-lived access
+// token, sends that token as a bearer token, and exchanges a new ID token
+// shortly before the access token expires.
+//
+// Use NewOIDCAuth to create one; the zero value is not usable.
+type OIDCAuth struct {
+	source IDTokenSource
+
+	client *Client
+	tokens tokenCache
+}
+
+// NewOIDCAuth returns an authenticator exchanging ID tokens from source.
+func NewOIDCAuth(source IDTokenSource) *OIDCAuth {
+	return &OIDCAuth{source: source}
+}
+
+// Authenticate implements Authenticator.
+func (a *OIDCAuth) Authenticate(ctx context.Context, req *http.Request) error {
+	if a.client == nil {
+		return errors.New("OIDC authenticator is not bound to a client")
+	}
+
+	token, err := a.tokens.get(ctx, a.exchange)
+	if err != nil {
+		return fmt.Errorf("failed to exchange OIDC ID token: %w", err)
+	}
+	req.Header.Set("Authorization", "Bearer "+token)
+	return nil
+}
+
+// Refresh implements Refresher by obtaining a new access token.
+func (a *OIDCAuth) Refresh(ctx context.Context, rejected *http.Request) error {
+	return a.tokens.refresh(ctx, bearerToken(rejected), a.exchange)
+}
+
+func (a *OIDCAuth) bindClient(c *Client) {
+	a.client = c
+}
+
+// exchange trades the current ID token for an access token.
+func (a *OIDCAuth) exchange(ctx context.Context) (*TokenResponse, error) {
+	idToken, err := a.source(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	req := TokenExchangeRequest{
+		GrantType:        tokenExchangeGrantType,
+		SubjectToken:     idToken,
+		SubjectTokenType: idTokenType,
+	}
+	var resp TokenResponse
+	if err := a.client.doRequest(ctx, http.MethodPost, tokenPath, req, &resp, asTokenExchange()); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
@@ -0,0 +1,62 @@
+// Copyright 2025 InferaDB
+// SPDX-License-Identifier: Apache-2.0
+
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestOIDCAuthExchangesIDToken(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == tokenPath {
+			var req TokenExchangeRequest
+			if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+				t.Errorf("decode token request: %v", err)
+			}
+			if req.GrantType != tokenExchangeGrantType || req.SubjectTokenType != idTokenType || req.SubjectToken != "id-t0ken" {
+				w.WriteHeader(http.StatusUnauthorized)
+				return
+			}
+			_, _ = w.Write([]byte(`{"access_token":"sess10n","token_type":"Bearer","expires_in":900}`))
+			return
+		}
+		if r.Header.Get("Authorization") != "Bearer sess10n" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		_, _ = w.Write([]byte(`{"organization":{"id":1}}`))
+	}))
+	defer srv.Close()
+
+	file := filepath.Join(t.TempDir(), "token")
+	if err := os.WriteFile(file, []byte("id-t0ken\n"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	t.Setenv("TEST_ID_TOKEN", "id-t0ken")
+
+	for name, source := range map[string]IDTokenSource{
+		"file": IDTokenFromFile(file),
+		"env":  IDTokenFromEnv("TEST_ID_TOKEN"),
+	} {
+		t.Run(name, func(t *testing.T) {
+			c := New(Config{Endpoint: srv.URL, Authenticator: NewOIDCAuth(source)})
+			if _, err := c.GetOrganization(context.Background(), "1"); err != nil {
+				t.Fatalf("GetOrganization: %v", err)
+			}
+		})
+	}
+}
+
+func TestOIDCAuthReportsMissingToken(t *testing.T) {
+	c := New(Config{Endpoint: "http://127.0.0.1:0", Authenticator: NewOIDCAuth(IDTokenFromEnv("TEST_UNSET_ID_TOKEN"))})
+	if _, err := c.GetOrganization(context.Background(), "1"); err == nil {
+		t.Fatal("expected error")
+	}
+}
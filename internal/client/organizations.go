@@ -30,7 +30,7 @@ func normalizeTier(apiTier string) string {
 // CreateOrganization creates a new organization.
 func (c *Client) CreateOrganization(ctx context.Context, req CreateOrganizationRequest) (*Organization, error) {
 	var resp OrganizationResponse
-	if err := c.post(ctx, "/v1/organizations", req, &resp); err != nil {
+	if err := c.create(ctx, "/v1/organizations", req, &resp, captureETag(&resp.Organization.ETag)); err != nil {
 		return nil, fmt.Errorf("failed to create organization: %w", err)
 	}
 	org := resp.Organization
@@ -42,7 +42,7 @@ func (c *Client) CreateOrganization(ctx context.Context, req CreateOrganizationR
 // GetOrganization retrieves an organization by ID.
 func (c *Client) GetOrganization(ctx context.Context, id string) (*Organization, error) {
 	var resp OrganizationResponse
-	if err := c.get(ctx, fmt.Sprintf("/v1/organizations/%s", id), &resp); err != nil {
+	if err := c.get(ctx, fmt.Sprintf("/v1/organizations/%s", id), &resp, captureETag(&resp.Organization.ETag)); err != nil {
 		return nil, fmt.Errorf("failed to get organization: %w", err)
 	}
 	org := resp.Organization
@@ -53,7 +53,7 @@ func (c *Client) GetOrganization(ctx context.Context, id string) (*Organization,
 // UpdateOrganization updates an organization.
 func (c *Client) UpdateOrganization(ctx context.Context, id string, req UpdateOrganizationRequest) (*Organization, error) {
 	var resp OrganizationResponse
-	if err := c.patch(ctx, fmt.Sprintf("/v1/organizations/%s", id), req, &resp); err != nil {
+	if err := c.patch(ctx, fmt.Sprintf("/v1/organizations/%s", id), req, &resp, withIfMatch(req.IfMatch), captureETag(&resp.Organization.ETag)); err != nil {
 		return nil, fmt.Errorf("failed to update organization: %w", err)
 	}
 	org := resp.Organization
@@ -69,15 +69,15 @@ func (c *Client) DeleteOrganization(ctx context.Context, id string) error {
 	return nil
 }
 
-// ListOrganizations lists all organizations the user belongs to.
+// ListOrganizations lists all organizations the user belongs to, fetching every page.
 func (c *Client) ListOrganizations(ctx context.Context) ([]Organization, error) {
-	var resp OrganizationListResponse
-	if err := c.get(ctx, "/v1/organizations", &resp); err != nil {
+	orgs, err := listAll[Organization](ctx, c, "/v1/organizations", "organizations")
+	if err != nil {
 		return nil, fmt.Errorf("failed to list organizations: %w", err)
 	}
 	// Normalize tiers
-	for i := range resp.Organizations {
-		resp.Organizations[i].Tier = normalizeTier(resp.Organizations[i].Tier)
+	for i := range orgs {
+		orgs[i].Tier = normalizeTier(orgs[i].Tier)
 	}
-	return resp.Organizations, nil
+	return orgs, nil
 }
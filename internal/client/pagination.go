@@ -0,0 +1,98 @@
+// Copyright 2025 InferaDB
+// SPDX-License-Identifier: Apache-2.0
+
+package client
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+)
+
+// DefaultPageSize is the number of items requested per page when
+// Config.PageSize is zero.
+const DefaultPageSize = 100
+
+// listAll fetches every page of a list endpoint, following offset/limit until
+// the API reports has_more=false.
+//
+// Paged endpoints wrap their items in an object under key, next to a
+// pagination block. Endpoints that still return a bare JSON array are not
+// paged by the API and are treated as a single, complete page.
+func listAll[T any](ctx context.Context, c *Client, path, key string) ([]T, error) {
+	items := []T{}
+	offset := 0
+	for {
+		var raw json.RawMessage
+		if err := c.get(ctx, pagePath(path, offset, c.pageSize), &raw); err != nil {
+			return nil, err
+		}
+
+		page, pagination, err := decodePage[T](raw, key)
+		if err != nil {
+			return nil, err
+		}
+		items = append(items, page...)
+
+		// Stop on an empty page as well, so a server that keeps reporting
+		// has_more cannot trap the client in a loop.
+		if pagination == nil || !pagination.HasMore || len(page) == 0 {
+			return items, nil
+		}
+		offset += len(page)
+	}
+}
+
+// decodePage decodes one page of a list response. The returned pagination is
+// nil when the response is a bare array.
+func decodePage[T any](raw json.RawMessage, key string) ([]T, *Pagination, error) {
+	raw = bytes.TrimSpace(raw)
+	if len(raw) == 0 {
+		return nil, nil, nil
+	}
+
+	if raw[0] == '[' {
+		var items []T
+		if err := json.Unmarshal(raw, &items); err != nil {
+			return nil, nil, fmt.Errorf("failed to unmarshal response: %w", err)
+		}
+		return items, nil, nil
+	}
+
+	var envelope map[string]json.RawMessage
+	if err := json.Unmarshal(raw, &envelope); err != nil {
+		return nil, nil, fmt.Errorf("failed to unmarshal response: %w", err)
+	}
+
+	var items []T
+	if data, ok := envelope[key]; ok {
+		if err := json.Unmarshal(data, &items); err != nil {
+			return nil, nil, fmt.Errorf("failed to unmarshal %s: %w", key, err)
+		}
+	}
+
+	var pagination Pagination
+	if data, ok := envelope["pagination"]; ok {
+		if err := json.Unmarshal(data, &pagination); err != nil {
+			return nil, nil, fmt.Errorf("failed to unmarshal pagination: %w", err)
+		}
+	}
+	return items, &pagination, nil
+}
+
+// pagePath appends offset and limit query parameters to an API path.
+func pagePath(path string, offset, limit int) string {
+	query := url.Values{}
+	query.Set("offset", strconv.Itoa(offset))
+	query.Set("limit", strconv.Itoa(limit))
+
+	sep := "?"
+	if strings.Contains(path, "?") {
+		sep = "&"
+	}
+	return path + sep + query.Encode()
+}
@@ -0,0 +1,106 @@
+// Copyright 2025 InferaDB
+// SPDX-License-Identifier: Apache-2.0
+
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+)
+
+func TestListVaultsFollowsPagination(t *testing.T) {
+	const total = 250
+	var requests int
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		offset, _ := strconv.Atoi(r.URL.Query().Get("offset"))
+		limit, _ := strconv.Atoi(r.URL.Query().Get("limit"))
+		if limit != 100 {
+			t.Errorf("limit = %d, want 100", limit)
+		}
+
+		end := min(offset+limit, total)
+		vaults := []map[string]any{}
+		for i := offset; i < end; i++ {
+			vaults = append(vaults, map[string]any{"id": i + 1, "organization_id": 1, "name": fmt.Sprintf("vault-%d", i)})
+		}
+		_ = json.NewEncoder(w).Encode(map[string]any{
+			"vaults": vaults,
+			"pagination": map[string]any{
+				"total": total, "count": len(vaults), "offset": offset, "limit": limit, "has_more": end < total,
+			},
+		})
+	}))
+	defer srv.Close()
+
+	c := New(Config{Endpoint: srv.URL})
+	vaults, err := c.ListVaults(context.Background(), "1")
+	if err != nil {
+		t.Fatalf("ListVaults: %v", err)
+	}
+	if len(vaults) != total {
+		t.Fatalf("got %d vaults, want %d", len(vaults), total)
+	}
+	if vaults[total-1].ID != SnowflakeID(strconv.Itoa(total)) {
+		t.Errorf("last vault id = %q, want %d", vaults[total-1].ID, total)
+	}
+	if requests != 3 {
+		t.Errorf("requests = %d, want 3", requests)
+	}
+}
+
+func TestListUnwrappedArrayIsSinglePage(t *testing.T) {
+	var requests int
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		_, _ = w.Write([]byte(`[{"id":"1","vault_id":"2","user_id":"3","role":"reader"}]`))
+	}))
+	defer srv.Close()
+
+	c := New(Config{Endpoint: srv.URL, PageSize: 1})
+	grants, err := c.ListVaultUserGrants(context.Background(), "1", "2")
+	if err != nil {
+		t.Fatalf("ListVaultUserGrants: %v", err)
+	}
+	if len(grants) != 1 || grants[0].Role != "reader" {
+		t.Fatalf("unexpected grants: %+v", grants)
+	}
+	if requests != 1 {
+		t.Errorf("requests = %d, want 1", requests)
+	}
+}
+
+func TestListStopsOnEmptyPage(t *testing.T) {
+	var requests int
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		_, _ = w.Write([]byte(`{"teams":[],"pagination":{"has_more":true}}`))
+	}))
+	defer srv.Close()
+
+	c := New(Config{Endpoint: srv.URL})
+	teams, err := c.ListTeams(context.Background(), "1")
+	if err != nil {
+		t.Fatalf("ListTeams: %v", err)
+	}
+	if len(teams) != 0 {
+		t.Errorf("got %d teams, want 0", len(teams))
+	}
+	if requests != 1 {
+		t.Errorf("requests = %d, want 1", requests)
+	}
+}
+
+func TestPagePath(t *testing.T) {
+	if got, want := pagePath("/v1/organizations", 0, 50), "/v1/organizations?limit=50&offset=0"; got != want {
+		t.Errorf("pagePath = %q, want %q", got, want)
+	}
+	if got, want := pagePath("/v1/organizations?sort=name", 100, 50), "/v1/organizations?sort=name&limit=50&offset=100"; got != want {
+		t.Errorf("pagePath = %q, want %q", got, want)
+	}
+}
@@ -0,0 +1,162 @@
+// Copyright 2025 InferaDB
+// SPDX-License-Identifier: Apache-2.0
+
+package client
+
+import (
+	"context"
+	"math"
+	"sync"
+	"time"
+)
+
+// DefaultMaxInFlight bounds concurrent requests when Config.MaxInFlight is
+// zero. It matches Terraform's default parallelism.
+const DefaultMaxInFlight = 10
+
+// throttleCooldown is the minimum time between two concurrency reductions, so
+// that a burst of 429 responses to requests sent together counts once.
+const throttleCooldown = time.Second
+
+// limiter paces outgoing requests with a token bucket and bounds how many are
+// in flight. The in-flight bound adapts to the server: it is halved when a
+// request is rate limited and grows back by one slot per window of
+// successful requests (additive increase, multiplicative decrease).
+type limiter struct {
+	mu sync.Mutex
+
+	// Token bucket. A zero rate disables pacing.
+	rate   float64
+	burst  float64
+	tokens float64
+	last   time.Time
+
+	// Adaptive concurrency.
+	maxInFlight   int
+	limit         float64
+	inFlight      int
+	lastThrottled time.Time
+	wake          chan struct{}
+}
+
+// newLimiter creates a limiter. A non-positive rate disables pacing and a
+// non-positive maxInFlight disables the concurrency bound.
+func newLimiter(rate float64, burst, maxInFlight int) *limiter {
+	if burst <= 0 {
+		burst = max(1, int(math.Ceil(rate)))
+	}
+	return &limiter{
+		rate:        rate,
+		burst:       float64(burst),
+		tokens:      float64(burst),
+		last:        time.Now(),
+		maxInFlight: maxInFlight,
+		limit:       float64(maxInFlight),
+		wake:        make(chan struct{}),
+	}
+}
+
+// acquire blocks until a request may be sent. Every successful acquire must
+// be paired with a call to release.
+func (l *limiter) acquire(ctx context.Context) error {
+	if err := l.acquireSlot(ctx); err != nil {
+		return err
+	}
+	if err := l.waitToken(ctx); err != nil {
+		l.freeSlot()
+		return err
+	}
+	return nil
+}
+
+// release frees the slot taken by acquire and adjusts the concurrency bound
+// depending on whether the server rate limited the request.
+func (l *limiter) release(throttled bool) {
+	if l.maxInFlight <= 0 {
+		return
+	}
+
+	l.mu.Lock()
+	if throttled {
+		if time.Since(l.lastThrottled) >= throttleCooldown {
+			l.limit = max(1, l.limit/2)
+			l.lastThrottled = time.Now()
+		}
+	} else if l.limit < float64(l.maxInFlight) {
+		l.limit = min(float64(l.maxInFlight), l.limit+1/l.limit)
+	}
+	l.mu.Unlock()
+
+	l.freeSlot()
+}
+
+// concurrency returns the current in-flight bound.
+func (l *limiter) concurrency() int {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	return int(l.limit)
+}
+
+func (l *limiter) acquireSlot(ctx context.Context) error {
+	if l.maxInFlight <= 0 {
+		return nil
+	}
+
+	for {
+		l.mu.Lock()
+		if l.inFlight < int(l.limit) {
+			l.inFlight++
+			l.mu.Unlock()
+			return nil
+		}
+		wake := l.wake
+		l.mu.Unlock()
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-wake:
+		}
+	}
+}
+
+// freeSlot returns an in-flight slot and wakes up waiting requests.
+func (l *limiter) freeSlot() {
+	if l.maxInFlight <= 0 {
+		return
+	}
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	l.inFlight--
+	close(l.wake)
+	l.wake = make(chan struct{})
+}
+
+func (l *limiter) waitToken(ctx context.Context) error {
+	if l.rate <= 0 {
+		return nil
+	}
+
+	// Reserve a token now and sleep until it has been refilled.
+	l.mu.Lock()
+	now := time.Now()
+	l.tokens = min(l.burst, l.tokens+now.Sub(l.last).Seconds()*l.rate)
+	l.last = now
+	l.tokens--
+	delay := time.Duration(-l.tokens / l.rate * float64(time.Second))
+	l.mu.Unlock()
+
+	if delay <= 0 {
+		return nil
+	}
+	if err := sleep(ctx, delay); err != nil {
+		// Hand the unused reservation back.
+		l.mu.Lock()
+		l.tokens++
+		l.mu.Unlock()
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,80 @@
+// Copyright 2025 InferaDB
+// SPDX-License-Identifier: Apache-2.0
+
+package client
+
+import (
+	"context"
+	"testing"
+	"time"
+)
+
+func TestLimiterHalvesConcurrencyWhenThrottled(t *testing.T) {
+	l := newLimiter(0, 0, 8)
+	ctx := context.Background()
+
+	if err := l.acquire(ctx); err != nil {
+		t.Fatalf("acquire: %v", err)
+	}
+	l.release(true)
+	if got := l.concurrency(); got != 4 {
+		t.Fatalf("concurrency after 429 = %d, want 4", got)
+	}
+
+	// A second 429 inside the cooldown window must not shrink the bound again.
+	if err := l.acquire(ctx); err != nil {
+		t.Fatalf("acquire: %v", err)
+	}
+	l.release(true)
+	if got := l.concurrency(); got != 4 {
+		t.Fatalf("concurrency after burst of 429s = %d, want 4", got)
+	}
+
+	// Successful requests grow the bound back up to the configured maximum.
+	for i := 0; i < 100; i++ {
+		if err := l.acquire(ctx); err != nil {
+			t.Fatalf("acquire: %v", err)
+		}
+		l.release(false)
+	}
+	if got := l.concurrency(); got != 8 {
+		t.Fatalf("concurrency after recovery = %d, want 8", got)
+	}
+}
+
+func TestLimiterBlocksAtMaxInFlight(t *testing.T) {
+	l := newLimiter(0, 0, 1)
+
+	if err := l.acquire(context.Background()); err != nil {
+		t.Fatalf("acquire: %v", err)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+	if err := l.acquire(ctx); err == nil {
+		t.Fatal("second acquire succeeded while the only slot was taken")
+	}
+
+	l.release(false)
+	if err := l.acquire(context.Background()); err != nil {
+		t.Fatalf("acquire after release: %v", err)
+	}
+}
+
+func TestLimiterPacesRequests(t *testing.T) {
+	l := newLimiter(50, 1, -1)
+	ctx := context.Background()
+
+	start := time.Now()
+	for i := 0; i < 4; i++ {
+		if err := l.acquire(ctx); err != nil {
+			t.Fatalf("acquire: %v", err)
+		}
+		l.release(false)
+	}
+
+	// One token is available immediately, the next three arrive every 20ms.
+	if elapsed := time.Since(start); elapsed < 50*time.Millisecond {
+		t.Fatalf("4 requests at 50/s took %v, want at least 50ms", elapsed)
+	}
+}
@@ -0,0 +1,300 @@
+// Copyright 2025 InferaDB
+// SPDX-License-Identifier: Apache-2.0
+
+package client
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"sync"
+)
+
+// Environment variables that put a Recorder in front of the transport. They
+// are meant for regression tests only.
+const (
+	// RecorderModeEnv selects the RecorderMode.
+	RecorderModeEnv = "INFERADB_VCR_MODE"
+
+	// RecorderCassetteEnv is the path of the cassette file.
+	RecorderCassetteEnv = "INFERADB_VCR_CASSETTE"
+)
+
+// RecorderMode selects whether a Recorder records or replays traffic.
+type RecorderMode string
+
+const (
+	// RecorderModeRecord sends requests to the API and writes every
+	// interaction to the cassette, replacing its previous contents.
+	RecorderModeRecord RecorderMode = "record"
+
+	// RecorderModeReplay answers requests from the cassette without
+	// contacting the API.
+	RecorderModeReplay RecorderMode = "replay"
+)
+
+// Recorder is an http.RoundTripper that records Control API interactions to
+// a cassette file and replays them deterministically.
+//
+// Interactions are keyed by method, path with query and request body.
+// Credential headers are never stored, and the values of secret JSON fields
+// such as private_key_pem and access_token are scrubbed from both bodies
+// before they are written or matched, so requests that differ only in their
+// credentials replay the same interaction. Interactions sharing a key are
+// replayed in the order they were recorded; once exhausted, the last one is
+// repeated.
+type Recorder struct {
+	mode     RecorderMode
+	next     http.RoundTripper
+	cassette *cassette
+}
+
+// NewRecorder returns a Recorder in the given mode for the cassette at path.
+// Requests are recorded through next, or http.DefaultTransport when nil.
+// Recorders of the same path share the cassette, so several clients of one
+// process record to, or replay from, a single file.
+func NewRecorder(mode RecorderMode, path string, next http.RoundTripper) (*Recorder, error) {
+	if mode != RecorderModeRecord && mode != RecorderModeReplay {
+		return nil, fmt.Errorf("unknown recorder mode %q, expected %q or %q", mode, RecorderModeRecord, RecorderModeReplay)
+	}
+	if path == "" {
+		return nil, errors.New("recorder cassette path is empty")
+	}
+	if next == nil {
+		next = http.DefaultTransport
+	}
+
+	c, err := openCassette(mode, path)
+	if err != nil {
+		return nil, err
+	}
+	return &Recorder{mode: mode, next: next, cassette: c}, nil
+}
+
+// RecorderFromEnv wraps next in a Recorder when RecorderModeEnv is set, and
+// returns next unchanged otherwise.
+func RecorderFromEnv(next http.RoundTripper) (http.RoundTripper, error) {
+	mode := os.Getenv(RecorderModeEnv)
+	if mode == "" {
+		return next, nil
+	}
+	path := os.Getenv(RecorderCassetteEnv)
+	if path == "" {
+		return nil, fmt.Errorf("%s is required when %s is set", RecorderCassetteEnv, RecorderModeEnv)
+	}
+	return NewRecorder(RecorderMode(mode), path, next)
+}
+
+// RoundTrip records or replays a single request.
+func (r *Recorder) RoundTrip(req *http.Request) (*http.Response, error) {
+	var body []byte
+	if req.Body != nil {
+		data, err := io.ReadAll(req.Body)
+		_ = req.Body.Close()
+		if err != nil {
+			return nil, fmt.Errorf("failed to read request body: %w", err)
+		}
+		body = data
+	}
+	key := interactionKey{method: req.Method, path: req.URL.RequestURI(), body: scrubBody(body)}
+
+	if r.mode == RecorderModeReplay {
+		in, ok := r.cassette.next(key)
+		if !ok {
+			return nil, fmt.Errorf("no recorded interaction for %s %s in cassette %s", key.method, key.path, r.cassette.path)
+		}
+		return in.response(req), nil
+	}
+
+	out := req.Clone(req.Context())
+	out.Body = io.NopCloser(bytes.NewReader(body))
+	resp, err := r.next.RoundTrip(out)
+	if err != nil {
+		return nil, err
+	}
+	respBody, err := io.ReadAll(resp.Body)
+	_ = resp.Body.Close()
+	if err != nil {
+		return nil, fmt.Errorf("failed to read response body: %w", err)
+	}
+	resp.Body = io.NopCloser(bytes.NewReader(respBody))
+
+	headers := make(http.Header)
+	for name, values := range resp.Header {
+		if !sensitiveHeaders[name] && name != "Date" {
+			headers[name] = values
+		}
+	}
+	err = r.cassette.record(interaction{
+		Method:          key.method,
+		Path:            key.path,
+		RequestBody:     key.body,
+		StatusCode:      resp.StatusCode,
+		ResponseHeaders: headers,
+		ResponseBody:    scrubBody(respBody),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+// interaction is a recorded request and its response.
+type interaction struct {
+	Method          string      `json:"method"`
+	Path            string      `json:"path"`
+	RequestBody     string      `json:"request_body,omitempty"`
+	StatusCode      int         `json:"status_code"`
+	ResponseHeaders http.Header `json:"response_headers,omitempty"`
+	ResponseBody    string      `json:"response_body,omitempty"`
+}
+
+func (in interaction) key() interactionKey {
+	return interactionKey{method: in.Method, path: in.Path, body: in.RequestBody}
+}
+
+// response builds the replayed response to req.
+func (in interaction) response(req *http.Request) *http.Response {
+	header := make(http.Header, len(in.ResponseHeaders))
+	for name, values := range in.ResponseHeaders {
+		header[name] = append([]string(nil), values...)
+	}
+	return &http.Response{
+		Status:        fmt.Sprintf("%d %s", in.StatusCode, http.StatusText(in.StatusCode)),
+		StatusCode:    in.StatusCode,
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Header:        header,
+		Body:          io.NopCloser(bytes.NewReader([]byte(in.ResponseBody))),
+		ContentLength: int64(len(in.ResponseBody)),
+		Request:       req,
+	}
+}
+
+// interactionKey identifies the interactions a request can be answered with.
+type interactionKey struct {
+	method string
+	path   string
+	body   string
+}
+
+// cassette is the file interactions are recorded to and replayed from.
+type cassette struct {
+	path string
+
+	mu           sync.Mutex
+	interactions []interaction
+	replayed     map[interactionKey]int
+}
+
+var (
+	cassettesMu sync.Mutex
+	cassettes   = map[string]*cassette{}
+)
+
+// openCassette returns the cassette at path, shared by every Recorder of the
+// process. Replay cassettes are read once; record cassettes start empty.
+func openCassette(mode RecorderMode, path string) (*cassette, error) {
+	abs, err := filepath.Abs(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to resolve cassette path: %w", err)
+	}
+
+	cassettesMu.Lock()
+	defer cassettesMu.Unlock()
+
+	if c, ok := cassettes[abs]; ok {
+		return c, nil
+	}
+
+	c := &cassette{path: path, replayed: map[interactionKey]int{}}
+	if mode == RecorderModeReplay {
+		data, err := os.ReadFile(path)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read cassette: %w", err)
+		}
+		var file struct {
+			Interactions []interaction `json:"interactions"`
+		}
+		if err := json.Unmarshal(data, &file); err != nil {
+			return nil, fmt.Errorf("failed to parse cassette %s: %w", path, err)
+		}
+		c.interactions = file.Interactions
+	}
+	cassettes[abs] = c
+	return c, nil
+}
+
+// next returns the next interaction recorded for key.
+func (c *cassette) next(key interactionKey) (interaction, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	var matches []interaction
+	for _, in := range c.interactions {
+		if in.key() == key {
+			matches = append(matches, in)
+		}
+	}
+	if len(matches) == 0 {
+		return interaction{}, false
+	}
+
+	i := min(c.replayed[key], len(matches)-1)
+	c.replayed[key]++
+	return matches[i], true
+}
+
+// record appends in to the cassette and rewrites the file, so interactions
+// survive a process that is not shut down cleanly.
+func (c *cassette) record(in interaction) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.interactions = append(c.interactions, in)
+	data, err := json.MarshalIndent(struct {
+		Interactions []interaction `json:"interactions"`
+	}{c.interactions}, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to encode cassette: %w", err)
+	}
+
+	if err := os.MkdirAll(filepath.Dir(c.path), 0o755); err != nil {
+		return fmt.Errorf("failed to write cassette: %w", err)
+	}
+	tmp := c.path + ".tmp"
+	if err := os.WriteFile(tmp, append(data, '\n'), 0o600); err != nil {
+		return fmt.Errorf("failed to write cassette: %w", err)
+	}
+	if err := os.Rename(tmp, c.path); err != nil {
+		return fmt.Errorf("failed to write cassette: %w", err)
+	}
+	return nil
+}
+
+// scrubBody returns body with the values of secret JSON fields replaced and
+// object keys in a canonical order. Bodies that are not JSON are returned
+// unchanged.
+func scrubBody(body []byte) string {
+	if len(body) == 0 {
+		return ""
+	}
+
+	var value interface{}
+	decoder := json.NewDecoder(bytes.NewReader(body))
+	decoder.UseNumber()
+	if err := decoder.Decode(&value); err != nil {
+		return string(body)
+	}
+	data, err := json.Marshal(redactValue(value))
+	if err != nil {
+		return string(body)
+	}
+	return string(data)
+}
@@ -0,0 +1,108 @@
+// Copyright 2025 InferaDB
+// SPDX-License-Identifier: Apache-2.0
+
+package client
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"strings"
+)
+
+// redacted replaces secret values in logged requests and responses.
+const redacted = "[REDACTED]"
+
+// maxLoggedBody bounds the size of a body passed to the Logger.
+const maxLoggedBody = 16 << 10
+
+// sensitiveHeaders always carry credentials.
+var sensitiveHeaders = map[string]bool{
+	"Authorization":       true,
+	"Cookie":              true,
+	"Proxy-Authorization": true,
+	"Set-Cookie":          true,
+}
+
+// sensitiveFields are JSON object keys whose values are secrets, such as the
+// private key returned when a certificate is created or the credentials sent
+// to the token endpoint.
+var sensitiveFields = map[string]bool{
+	"access_token":     true,
+	"client_assertion": true,
+	"client_secret":    true,
+	"password":         true,
+	"private_key_pem":  true,
+	"refresh_token":    true,
+	"secret":           true,
+	"session_token":    true,
+	"subject_token":    true,
+	"token":            true,
+}
+
+// redactHeaders returns a copy of h with credentials replaced. Headers named
+// in extra, such as those added by the Authenticator or configured by the
+// user, are redacted as well.
+func redactHeaders(h http.Header, extra map[string]bool) http.Header {
+	out := make(http.Header, len(h))
+	for name, values := range h {
+		if sensitiveHeaders[name] || extra[name] {
+			out[name] = []string{redacted}
+			continue
+		}
+		out[name] = append([]string(nil), values...)
+	}
+	return out
+}
+
+// changedHeaders returns the names of the headers whose values differ
+// between before and after.
+func changedHeaders(before, after http.Header) map[string]bool {
+	changed := make(map[string]bool)
+	for name, values := range after {
+		if strings.Join(values, "\n") != strings.Join(before[name], "\n") {
+			changed[name] = true
+		}
+	}
+	return changed
+}
+
+// redactBody returns body with the values of sensitive JSON fields replaced.
+// Bodies that are not JSON are returned unchanged. Large bodies are truncated.
+func redactBody(body []byte) string {
+	if len(body) == 0 {
+		return ""
+	}
+
+	var value interface{}
+	decoder := json.NewDecoder(bytes.NewReader(body))
+	decoder.UseNumber()
+	if err := decoder.Decode(&value); err == nil {
+		if data, err := json.Marshal(redactValue(value)); err == nil {
+			body = data
+		}
+	}
+
+	if len(body) > maxLoggedBody {
+		return string(body[:maxLoggedBody]) + "...(truncated)"
+	}
+	return string(body)
+}
+
+func redactValue(value interface{}) interface{} {
+	switch v := value.(type) {
+	case map[string]interface{}:
+		for key, field := range v {
+			if sensitiveFields[strings.ToLower(key)] {
+				v[key] = redacted
+				continue
+			}
+			v[key] = redactValue(field)
+		}
+	case []interface{}:
+		for i, item := range v {
+			v[i] = redactValue(item)
+		}
+	}
+	return value
+}
@@ -0,0 +1,163 @@
+// Copyright 2025 InferaDB
+// SPDX-License-Identifier: Apache-2.0
+
+package client
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"math/rand/v2"
+	"net"
+	"net/http"
+	"net/url"
+	"strconv"
+	"time"
+)
+
+const (
+	// DefaultMaxRetries is the number of times a transient failure is retried
+	// when Config.MaxRetries is zero.
+	DefaultMaxRetries = 3
+
+	// DefaultRetryMaxWait caps the delay between two attempts when
+	// Config.RetryMaxWait is zero.
+	DefaultRetryMaxWait = 30 * time.Second
+
+	// retryBaseWait is the delay before the first retry, doubled on every attempt.
+	retryBaseWait = 500 * time.Millisecond
+)
+
+// retryDelay reports whether a failed attempt should be retried and how long
+// to wait before doing so. Exactly one of resp and err is non-nil. Requests
+// that are not idempotent are only retried when the server cannot have
+// processed them.
+func (c *Client) retryDelay(idempotent bool, attempt int, resp *http.Response, err error) (time.Duration, bool) {
+	if attempt >= c.maxRetries {
+		return 0, false
+	}
+
+	if err != nil {
+		if !isRetryableError(err) {
+			return 0, false
+		}
+		// A POST that failed after the connection was established may have been
+		// committed by the server. Only retry it when nothing was sent.
+		if !idempotent && !isDialError(err) {
+			return 0, false
+		}
+		return c.backoff(attempt), true
+	}
+
+	switch resp.StatusCode {
+	case http.StatusTooManyRequests:
+		// Rate-limited requests are rejected before processing, so they are
+		// safe to retry regardless of method.
+	case http.StatusBadGateway, http.StatusServiceUnavailable, http.StatusGatewayTimeout:
+		if !idempotent {
+			return 0, false
+		}
+	default:
+		return 0, false
+	}
+
+	wait := c.backoff(attempt)
+	if retryAfter, ok := parseRetryAfter(resp.Header.Get("Retry-After")); ok {
+		wait = min(retryAfter, c.retryMaxWait)
+	}
+	return wait, true
+}
+
+// backoff returns a jittered exponential delay for the given attempt, capped at
+// the configured maximum wait.
+func (c *Client) backoff(attempt int) time.Duration {
+	wait := c.retryMaxWait
+	if attempt < 30 {
+		wait = min(retryBaseWait<<attempt, c.retryMaxWait)
+	}
+	// Equal jitter: keep half of the delay and randomize the other half so
+	// that parallel Terraform operations do not retry in lockstep.
+	half := wait / 2
+	return half + rand.N(half+1)
+}
+
+// parseRetryAfter parses a Retry-After header given either as a number of
+// seconds or as an HTTP date.
+func parseRetryAfter(value string) (time.Duration, bool) {
+	if value == "" {
+		return 0, false
+	}
+	if seconds, err := strconv.Atoi(value); err == nil {
+		if seconds < 0 {
+			return 0, false
+		}
+		return time.Duration(seconds) * time.Second, true
+	}
+	if date, err := http.ParseTime(value); err == nil {
+		return max(time.Until(date), 0), true
+	}
+	return 0, false
+}
+
+// isIdempotent reports whether a request can be replayed without side effects.
+// PATCH requests sent by this client always carry absolute values, so
+// replaying one converges on the same state.
+func isIdempotent(method string) bool {
+	switch method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions, http.MethodPut, http.MethodPatch, http.MethodDelete:
+		return true
+	}
+	return false
+}
+
+// isRetryableError reports whether a transport error is likely transient.
+func isRetryableError(err error) bool {
+	if errors.Is(err, context.Canceled) {
+		return false
+	}
+
+	// Token exchanges performed by the authenticator have already been retried.
+	var authErr *authenticationError
+	if errors.As(err, &authErr) {
+		return false
+	}
+
+	// A malformed endpoint URL is a configuration error.
+	var urlErr *url.Error
+	if errors.As(err, &urlErr) && urlErr.Op == "parse" {
+		return false
+	}
+
+	// Certificate problems will not fix themselves on the next attempt.
+	var certErr *tls.CertificateVerificationError
+	var unknownAuthority x509.UnknownAuthorityError
+	var hostnameErr x509.HostnameError
+	var invalidCert x509.CertificateInvalidError
+	if errors.As(err, &certErr) || errors.As(err, &unknownAuthority) ||
+		errors.As(err, &hostnameErr) || errors.As(err, &invalidCert) {
+		return false
+	}
+
+	return true
+}
+
+// isDialError reports whether the error happened while establishing the
+// connection, meaning the request never reached the server.
+func isDialError(err error) bool {
+	var opErr *net.OpError
+	return errors.As(err, &opErr) && opErr.Op == "dial"
+}
+
+// sleep waits for the given duration or until the context is done.
+func sleep(ctx context.Context, d time.Duration) error {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
@@ -0,0 +1,143 @@
+// Copyright 2025 InferaDB
+// SPDX-License-Identifier: Apache-2.0
+
+package client
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestDoRequestRetriesTransientFailures(t *testing.T) {
+	var calls atomic.Int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if calls.Add(1) < 3 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		_, _ = w.Write([]byte(`{"organization":{"id":1,"name":"acme","tier":"TIER_DEV_V1"}}`))
+	}))
+	defer srv.Close()
+
+	c := New(Config{Endpoint: srv.URL, RetryMaxWait: 10 * time.Millisecond})
+	org, err := c.GetOrganization(context.Background(), "1")
+	if err != nil {
+		t.Fatalf("GetOrganization: %v", err)
+	}
+	if org.Name != "acme" {
+		t.Errorf("name = %q, want %q", org.Name, "acme")
+	}
+	if got := calls.Load(); got != 3 {
+		t.Errorf("calls = %d, want 3", got)
+	}
+}
+
+func TestDoRequestGivesUpAfterMaxRetries(t *testing.T) {
+	var calls atomic.Int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls.Add(1)
+		w.WriteHeader(http.StatusBadGateway)
+	}))
+	defer srv.Close()
+
+	c := New(Config{Endpoint: srv.URL, MaxRetries: 2, RetryMaxWait: 10 * time.Millisecond})
+	if _, err := c.GetVault(context.Background(), "1", "2"); err == nil {
+		t.Fatal("expected error")
+	}
+	if got := calls.Load(); got != 3 {
+		t.Errorf("calls = %d, want 3", got)
+	}
+}
+
+func TestDoRequestDoesNotRetryUnsafePost(t *testing.T) {
+	var calls atomic.Int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls.Add(1)
+		w.WriteHeader(http.StatusBadGateway)
+	}))
+	defer srv.Close()
+
+	// Create methods send an idempotency key and may be retried; a plain POST may not.
+	c := New(Config{Endpoint: srv.URL, RetryMaxWait: 10 * time.Millisecond})
+	if err := c.RevokeCertificate(context.Background(), "1", "2", "3"); err == nil {
+		t.Fatal("expected error")
+	}
+	if got := calls.Load(); got != 1 {
+		t.Errorf("calls = %d, want 1", got)
+	}
+}
+
+func TestDoRequestRetriesRateLimitedPost(t *testing.T) {
+	var calls atomic.Int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if calls.Add(1) == 1 {
+			w.Header().Set("Retry-After", "0")
+			w.WriteHeader(http.StatusTooManyRequests)
+			return
+		}
+		_, _ = w.Write([]byte(`{"vault":{"id":2,"organization_id":1,"name":"v"}}`))
+	}))
+	defer srv.Close()
+
+	c := New(Config{Endpoint: srv.URL, RetryMaxWait: 10 * time.Millisecond})
+	vault, err := c.CreateVault(context.Background(), "1", CreateVaultRequest{Name: "v"})
+	if err != nil {
+		t.Fatalf("CreateVault: %v", err)
+	}
+	if vault.ID != "2" {
+		t.Errorf("id = %q, want %q", vault.ID, "2")
+	}
+	if got := calls.Load(); got != 2 {
+		t.Errorf("calls = %d, want 2", got)
+	}
+}
+
+func TestDoRequestRetriesDisabled(t *testing.T) {
+	var calls atomic.Int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls.Add(1)
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer srv.Close()
+
+	c := New(Config{Endpoint: srv.URL, MaxRetries: -1})
+	if _, err := c.GetTeam(context.Background(), "1", "2"); err == nil {
+		t.Fatal("expected error")
+	}
+	if got := calls.Load(); got != 1 {
+		t.Errorf("calls = %d, want 1", got)
+	}
+}
+
+func TestParseRetryAfter(t *testing.T) {
+	tests := []struct {
+		value string
+		want  time.Duration
+		ok    bool
+	}{
+		{"", 0, false},
+		{"5", 5 * time.Second, true},
+		{"-1", 0, false},
+		{"soon", 0, false},
+		{"Wed, 21 Oct 2015 07:28:00 GMT", 0, true},
+	}
+	for _, tt := range tests {
+		got, ok := parseRetryAfter(tt.value)
+		if ok != tt.ok || got != tt.want {
+			t.Errorf("parseRetryAfter(%q) = %v, %v; want %v, %v", tt.value, got, ok, tt.want, tt.ok)
+		}
+	}
+}
+
+func TestBackoffIsCapped(t *testing.T) {
+	c := New(Config{RetryMaxWait: time.Second})
+	for attempt := 0; attempt < 64; attempt++ {
+		if wait := c.backoff(attempt); wait > time.Second || wait < 0 {
+			t.Fatalf("backoff(%d) = %v, want within [0, 1s]", attempt, wait)
+		}
+	}
+}
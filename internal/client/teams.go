@@ -11,7 +11,7 @@ import (
 // CreateTeam creates a new team in an organization.
 func (c *Client) CreateTeam(ctx context.Context, orgID string, req CreateTeamRequest) (*Team, error) {
 	var resp TeamResponse
-	if err := c.post(ctx, fmt.Sprintf("/v1/organizations/%s/teams", orgID), req, &resp); err != nil {
+	if err := c.create(ctx, fmt.Sprintf("/v1/organizations/%s/teams", orgID), req, &resp, captureETag(&resp.Team.ETag)); err != nil {
 		return nil, fmt.Errorf("failed to create team: %w", err)
 	}
 	return &resp.Team, nil
@@ -21,7 +21,7 @@ func (c *Client) CreateTeam(ctx context.Context, orgID string, req CreateTeamReq
 func (c *Client) GetTeam(ctx context.Context, orgID, teamID string) (*Team, error) {
 	// Note: GET endpoint returns team directly, not wrapped
 	var team Team
-	if err := c.get(ctx, fmt.Sprintf("/v1/organizations/%s/teams/%s", orgID, teamID), &team); err != nil {
+	if err := c.get(ctx, fmt.Sprintf("/v1/organizations/%s/teams/%s", orgID, teamID), &team, captureETag(&team.ETag)); err != nil {
 		return nil, fmt.Errorf("failed to get team: %w", err)
 	}
 	return &team, nil
@@ -30,7 +30,7 @@ func (c *Client) GetTeam(ctx context.Context, orgID, teamID string) (*Team, erro
 // UpdateTeam updates a team.
 func (c *Client) UpdateTeam(ctx context.Context, orgID, teamID string, req UpdateTeamRequest) (*Team, error) {
 	var resp TeamResponse
-	if err := c.patch(ctx, fmt.Sprintf("/v1/organizations/%s/teams/%s", orgID, teamID), req, &resp); err != nil {
+	if err := c.patch(ctx, fmt.Sprintf("/v1/organizations/%s/teams/%s", orgID, teamID), req, &resp, withIfMatch(req.IfMatch), captureETag(&resp.Team.ETag)); err != nil {
 		return nil, fmt.Errorf("failed to update team: %w", err)
 	}
 	return &resp.Team, nil
@@ -44,19 +44,19 @@ func (c *Client) DeleteTeam(ctx context.Context, orgID, teamID string) error {
 	return nil
 }
 
-// ListTeams lists all teams in an organization.
+// ListTeams lists all teams in an organization, fetching every page.
 func (c *Client) ListTeams(ctx context.Context, orgID string) ([]Team, error) {
-	var resp TeamListResponse
-	if err := c.get(ctx, fmt.Sprintf("/v1/organizations/%s/teams", orgID), &resp); err != nil {
+	teams, err := listAll[Team](ctx, c, fmt.Sprintf("/v1/organizations/%s/teams", orgID), "teams")
+	if err != nil {
 		return nil, fmt.Errorf("failed to list teams: %w", err)
 	}
-	return resp.Teams, nil
+	return teams, nil
 }
 
 // AddTeamMember adds a user to a team.
 func (c *Client) AddTeamMember(ctx context.Context, orgID, teamID string, req AddTeamMemberRequest) (*TeamMember, error) {
 	var member TeamMember
-	if err := c.post(ctx, fmt.Sprintf("/v1/organizations/%s/teams/%s/members", orgID, teamID), req, &member); err != nil {
+	if err := c.create(ctx, fmt.Sprintf("/v1/organizations/%s/teams/%s/members", orgID, teamID), req, &member, captureETag(&member.ETag)); err != nil {
 		return nil, fmt.Errorf("failed to add team member: %w", err)
 	}
 	return &member, nil
@@ -65,7 +65,7 @@ func (c *Client) AddTeamMember(ctx context.Context, orgID, teamID string, req Ad
 // GetTeamMember retrieves a team member by ID.
 func (c *Client) GetTeamMember(ctx context.Context, orgID, teamID, memberID string) (*TeamMember, error) {
 	var member TeamMember
-	if err := c.get(ctx, fmt.Sprintf("/v1/organizations/%s/teams/%s/members/%s", orgID, teamID, memberID), &member); err != nil {
+	if err := c.get(ctx, fmt.Sprintf("/v1/organizations/%s/teams/%s/members/%s", orgID, teamID, memberID), &member, captureETag(&member.ETag)); err != nil {
 		return nil, fmt.Errorf("failed to get team member: %w", err)
 	}
 	return &member, nil
@@ -74,7 +74,7 @@ func (c *Client) GetTeamMember(ctx context.Context, orgID, teamID, memberID stri
 // UpdateTeamMember updates a team member's role.
 func (c *Client) UpdateTeamMember(ctx context.Context, orgID, teamID, memberID string, req UpdateTeamMemberRequest) (*TeamMember, error) {
 	var member TeamMember
-	if err := c.patch(ctx, fmt.Sprintf("/v1/organizations/%s/teams/%s/members/%s", orgID, teamID, memberID), req, &member); err != nil {
+	if err := c.patch(ctx, fmt.Sprintf("/v1/organizations/%s/teams/%s/members/%s", orgID, teamID, memberID), req, &member, withIfMatch(req.IfMatch), captureETag(&member.ETag)); err != nil {
 		return nil, fmt.Errorf("failed to update team member: %w", err)
 	}
 	return &member, nil
@@ -88,10 +88,10 @@ func (c *Client) RemoveTeamMember(ctx context.Context, orgID, teamID, memberID s
 	return nil
 }
 
-// ListTeamMembers lists all members of a team.
+// ListTeamMembers lists all members of a team, fetching every page.
 func (c *Client) ListTeamMembers(ctx context.Context, orgID, teamID string) ([]TeamMember, error) {
-	var members []TeamMember
-	if err := c.get(ctx, fmt.Sprintf("/v1/organizations/%s/teams/%s/members", orgID, teamID), &members); err != nil {
+	members, err := listAll[TeamMember](ctx, c, fmt.Sprintf("/v1/organizations/%s/teams/%s/members", orgID, teamID), "members")
+	if err != nil {
 		return nil, fmt.Errorf("failed to list team members: %w", err)
 	}
 	return members, nil
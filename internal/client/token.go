@@ -0,0 +1,85 @@
+// Copyright 2025 InferaDB
+// SPDX-License-Identifier: Apache-2.0
+
+package client
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+)
+
+// tokenPath is the Control API endpoint that issues access tokens.
+const tokenPath = "/v1/auth/token"
+
+// maxTokenRefreshSkew is how long before expiry a cached token is replaced.
+// Short-lived tokens are refreshed after 80% of their lifetime instead.
+const maxTokenRefreshSkew = time.Minute
+
+// defaultTokenLifetime is assumed when the token endpoint omits expires_in.
+const defaultTokenLifetime = 5 * time.Minute
+
+// tokenCache holds an access token obtained from the API and fetches a new
+// one shortly before it expires. Concurrent callers share a single fetch.
+type tokenCache struct {
+	mu        sync.Mutex
+	token     string
+	refreshAt time.Time
+}
+
+// get returns the cached token, calling fetch when there is none or it is
+// about to expire.
+func (tc *tokenCache) get(ctx context.Context, fetch func(context.Context) (*TokenResponse, error)) (string, error) {
+	tc.mu.Lock()
+	defer tc.mu.Unlock()
+
+	if tc.token != "" && time.Now().Before(tc.refreshAt) {
+		return tc.token, nil
+	}
+	return tc.fetchLocked(ctx, fetch)
+}
+
+// refresh replaces a token the API rejected. Requests that failed
+// concurrently with the same token share one fetch; when the token was
+// already replaced, refresh does nothing.
+func (tc *tokenCache) refresh(ctx context.Context, rejected string, fetch func(context.Context) (*TokenResponse, error)) error {
+	tc.mu.Lock()
+	defer tc.mu.Unlock()
+
+	if tc.token != "" && tc.token != rejected {
+		return nil
+	}
+	_, err := tc.fetchLocked(ctx, fetch)
+	return err
+}
+
+// fetchLocked obtains and caches a new token. tc.mu must be held.
+func (tc *tokenCache) fetchLocked(ctx context.Context, fetch func(context.Context) (*TokenResponse, error)) (string, error) {
+	issued := time.Now()
+	resp, err := fetch(ctx)
+	if err != nil {
+		return "", err
+	}
+	if resp.AccessToken == "" {
+		return "", errors.New("token endpoint returned no access_token")
+	}
+
+	lifetime := time.Duration(resp.ExpiresIn) * time.Second
+	if lifetime <= 0 {
+		lifetime = defaultTokenLifetime
+	}
+	tc.token = resp.AccessToken
+	tc.refreshAt = issued.Add(lifetime - min(maxTokenRefreshSkew, lifetime/5))
+	return tc.token, nil
+}
+
+// bearerToken returns the bearer token a request was sent with.
+func bearerToken(req *http.Request) string {
+	if req == nil {
+		return ""
+	}
+	return strings.TrimPrefix(req.Header.Get("Authorization"), "Bearer ")
+}
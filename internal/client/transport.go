@@ -0,0 +1,86 @@
+// Copyright 2025 InferaDB
+// SPDX-License-Identifier: Apache-2.0
+
+package client
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"time"
+)
+
+// DefaultTimeout bounds a single attempt of a request, including reading the
+// response body.
+const DefaultTimeout = 30 * time.Second
+
+// TransportConfig describes how connections to the Control API are made.
+type TransportConfig struct {
+	// CACertPEM holds PEM encoded certificates trusted in addition to the
+	// system roots, e.g. the internal CA of a private deployment.
+	CACertPEM string
+
+	// ClientCertPEM and ClientKeyPEM hold the PEM encoded certificate and
+	// private key presented for mutual TLS. Both or neither must be set.
+	ClientCertPEM string
+	ClientKeyPEM  string
+
+	// InsecureSkipVerify disables server certificate verification. It is
+	// meant for local development only.
+	InsecureSkipVerify bool
+
+	// ProxyURL is the proxy requests are sent through. When empty, the
+	// HTTP_PROXY, HTTPS_PROXY and NO_PROXY environment variables apply.
+	ProxyURL string
+}
+
+// NewTransport returns an HTTP transport configured by cfg, based on the
+// defaults of http.DefaultTransport.
+func NewTransport(cfg TransportConfig) (*http.Transport, error) {
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+
+	tlsConfig := &tls.Config{
+		MinVersion:         tls.VersionTLS12,
+		InsecureSkipVerify: cfg.InsecureSkipVerify, //nolint:gosec // Opt-in for local development.
+	}
+
+	if cfg.CACertPEM != "" {
+		pool, err := x509.SystemCertPool()
+		if err != nil {
+			pool = x509.NewCertPool()
+		}
+		if !pool.AppendCertsFromPEM([]byte(cfg.CACertPEM)) {
+			return nil, errors.New("CA certificate bundle contains no PEM encoded certificates")
+		}
+		tlsConfig.RootCAs = pool
+	}
+
+	if (cfg.ClientCertPEM == "") != (cfg.ClientKeyPEM == "") {
+		return nil, errors.New("client certificate and client key must be set together")
+	}
+	if cfg.ClientCertPEM != "" {
+		cert, err := tls.X509KeyPair([]byte(cfg.ClientCertPEM), []byte(cfg.ClientKeyPEM))
+		if err != nil {
+			return nil, fmt.Errorf("failed to load client certificate: %w", err)
+		}
+		tlsConfig.Certificates = []tls.Certificate{cert}
+	}
+
+	transport.TLSClientConfig = tlsConfig
+
+	if cfg.ProxyURL != "" {
+		proxyURL, err := url.Parse(cfg.ProxyURL)
+		if err != nil {
+			return nil, fmt.Errorf("invalid proxy URL: %w", err)
+		}
+		if proxyURL.Scheme == "" || proxyURL.Host == "" {
+			return nil, fmt.Errorf("invalid proxy URL %q: scheme and host are required", cfg.ProxyURL)
+		}
+		transport.Proxy = http.ProxyURL(proxyURL)
+	}
+
+	return transport, nil
+}
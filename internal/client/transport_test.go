@@ -0,0 +1,161 @@
+// Copyright 2025 InferaDB
+// SPDX-License-Identifier: Apache-2.0
+
+package client
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"errors"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestTransportTrustsCustomCA(t *testing.T) {
+	srv := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("X-Tenant") != "acme" {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		_, _ = w.Write([]byte(`{"organization":{"id":1}}`))
+	}))
+	defer srv.Close()
+
+	headers := map[string]string{"X-Tenant": "acme"}
+
+	// The test server's certificate is not trusted by the system roots.
+	c := New(Config{Endpoint: srv.URL, MaxRetries: -1, Headers: headers})
+	if _, err := c.GetOrganization(context.Background(), "1"); err == nil {
+		t.Fatal("expected certificate verification error")
+	}
+
+	caPEM := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: srv.Certificate().Raw})
+	transport, err := NewTransport(TransportConfig{CACertPEM: string(caPEM)})
+	if err != nil {
+		t.Fatalf("NewTransport: %v", err)
+	}
+	c = New(Config{Endpoint: srv.URL, Transport: transport, Headers: headers})
+	if _, err := c.GetOrganization(context.Background(), "1"); err != nil {
+		t.Fatalf("GetOrganization: %v", err)
+	}
+}
+
+func TestTransportPresentsClientCertificate(t *testing.T) {
+	srv := httptest.NewUnstartedServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if len(r.TLS.PeerCertificates) == 0 || r.TLS.PeerCertificates[0].Subject.CommonName != "terraform" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		_, _ = w.Write([]byte(`{"organization":{"id":1}}`))
+	}))
+	srv.TLS = &tls.Config{ClientAuth: tls.RequireAnyClientCert}
+	srv.StartTLS()
+	defer srv.Close()
+
+	certPEM, keyPEM := selfSignedCertificate(t, "terraform")
+	transport, err := NewTransport(TransportConfig{
+		ClientCertPEM:      certPEM,
+		ClientKeyPEM:       keyPEM,
+		InsecureSkipVerify: true,
+	})
+	if err != nil {
+		t.Fatalf("NewTransport: %v", err)
+	}
+
+	c := New(Config{Endpoint: srv.URL, Transport: transport})
+	if _, err := c.GetOrganization(context.Background(), "1"); err != nil {
+		t.Fatalf("GetOrganization: %v", err)
+	}
+}
+
+func TestTimeoutBoundsAttemptsWithinDeadline(t *testing.T) {
+	var calls atomic.Int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if calls.Add(1) == 1 {
+			// The first attempt hangs past the request timeout.
+			select {
+			case <-r.Context().Done():
+			case <-time.After(5 * time.Second):
+			}
+			return
+		}
+		_, _ = w.Write([]byte(`{"organization":{"id":1}}`))
+	}))
+	defer srv.Close()
+
+	c := New(Config{Endpoint: srv.URL, Timeout: 50 * time.Millisecond, MaxRetries: 2, RetryMaxWait: 10 * time.Millisecond, CacheTTL: -1})
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+	if _, err := c.GetOrganization(ctx, "1"); err != nil {
+		t.Fatalf("GetOrganization with deadline: %v", err)
+	}
+	if got := calls.Load(); got != 2 {
+		t.Errorf("calls = %d, want 2", got)
+	}
+}
+
+func TestDeadlineBoundsAttempts(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		select {
+		case <-r.Context().Done():
+		case <-time.After(5 * time.Second):
+		}
+	}))
+	defer srv.Close()
+
+	c := New(Config{Endpoint: srv.URL, Timeout: time.Minute, MaxRetries: -1, CacheTTL: -1})
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	if _, err := c.GetOrganization(ctx, "1"); !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("err = %v, want deadline exceeded", err)
+	}
+}
+
+func TestNewTransportRejectsInvalidConfig(t *testing.T) {
+	certPEM, _ := selfSignedCertificate(t, "terraform")
+	for name, cfg := range map[string]TransportConfig{
+		"CA without certificates": {CACertPEM: "not a certificate"},
+		"certificate without key": {ClientCertPEM: certPEM},
+		"proxy without host":      {ProxyURL: "proxy.internal:3128"},
+	} {
+		if _, err := NewTransport(cfg); err == nil {
+			t.Errorf("%s: expected error", name)
+		}
+	}
+}
+
+func selfSignedCertificate(t *testing.T, commonName string) (certPEM, keyPEM string) {
+	t.Helper()
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: commonName},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	keyDER, err := x509.MarshalPKCS8PrivateKey(key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der})),
+		string(pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: keyDER}))
+}
@@ -6,12 +6,13 @@ package client
 import (
 	"context"
 	"fmt"
+	"time"
 )
 
 // CreateVault creates a new vault in an organization.
 func (c *Client) CreateVault(ctx context.Context, orgID string, req CreateVaultRequest) (*Vault, error) {
 	var resp VaultResponse
-	if err := c.post(ctx, fmt.Sprintf("/v1/organizations/%s/vaults", orgID), req, &resp); err != nil {
+	if err := c.create(ctx, fmt.Sprintf("/v1/organizations/%s/vaults", orgID), req, &resp, captureETag(&resp.Vault.ETag)); err != nil {
 		return nil, fmt.Errorf("failed to create vault: %w", err)
 	}
 	return &resp.Vault, nil
@@ -21,16 +22,45 @@ func (c *Client) CreateVault(ctx context.Context, orgID string, req CreateVaultR
 func (c *Client) GetVault(ctx context.Context, orgID, vaultID string) (*Vault, error) {
 	// Note: GET endpoint returns vault directly, not wrapped
 	var vault Vault
-	if err := c.get(ctx, fmt.Sprintf("/v1/organizations/%s/vaults/%s", orgID, vaultID), &vault); err != nil {
+	if err := c.get(ctx, fmt.Sprintf("/v1/organizations/%s/vaults/%s", orgID, vaultID), &vault, captureETag(&vault.ETag)); err != nil {
 		return nil, fmt.Errorf("failed to get vault: %w", err)
 	}
 	return &vault, nil
 }
 
+// WaitForVaultSync polls a vault every interval until its sync status is no
+// longer pending and returns the vault as last read. It gives up when ctx is
+// done; the error is then returned together with the last vault read, if any.
+func (c *Client) WaitForVaultSync(ctx context.Context, orgID, vaultID string, interval time.Duration) (*Vault, error) {
+	path := fmt.Sprintf("/v1/organizations/%s/vaults/%s", orgID, vaultID)
+	var last *Vault
+	for {
+		var vault Vault
+		if err := c.get(ctx, path, &vault, captureETag(&vault.ETag), uncached()); err != nil {
+			if last != nil && ctx.Err() != nil {
+				return last, fmt.Errorf("vault %s is still %s: %w", vaultID, VaultSyncPending, ctx.Err())
+			}
+			return last, fmt.Errorf("failed to get vault: %w", err)
+		}
+		if vault.SyncStatus != VaultSyncPending {
+			return &vault, nil
+		}
+		last = &vault
+
+		timer := time.NewTimer(interval)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return last, fmt.Errorf("vault %s is still %s: %w", vaultID, VaultSyncPending, ctx.Err())
+		case <-timer.C:
+		}
+	}
+}
+
 // UpdateVault updates a vault.
 func (c *Client) UpdateVault(ctx context.Context, orgID, vaultID string, req UpdateVaultRequest) (*Vault, error) {
 	var resp VaultResponse
-	if err := c.patch(ctx, fmt.Sprintf("/v1/organizations/%s/vaults/%s", orgID, vaultID), req, &resp); err != nil {
+	if err := c.patch(ctx, fmt.Sprintf("/v1/organizations/%s/vaults/%s", orgID, vaultID), req, &resp, withIfMatch(req.IfMatch), captureETag(&resp.Vault.ETag)); err != nil {
 		return nil, fmt.Errorf("failed to update vault: %w", err)
 	}
 	return &resp.Vault, nil
@@ -44,11 +74,11 @@ func (c *Client) DeleteVault(ctx context.Context, orgID, vaultID string) error {
 	return nil
 }
 
-// ListVaults lists all vaults in an organization.
+// ListVaults lists all vaults in an organization, fetching every page.
 func (c *Client) ListVaults(ctx context.Context, orgID string) ([]Vault, error) {
-	var resp VaultListResponse
-	if err := c.get(ctx, fmt.Sprintf("/v1/organizations/%s/vaults", orgID), &resp); err != nil {
+	vaults, err := listAll[Vault](ctx, c, fmt.Sprintf("/v1/organizations/%s/vaults", orgID), "vaults")
+	if err != nil {
 		return nil, fmt.Errorf("failed to list vaults: %w", err)
 	}
-	return resp.Vaults, nil
+	return vaults, nil
 }
@@ -0,0 +1,58 @@
+// Copyright 2025 InferaDB
+// SPDX-License-Identifier: Apache-2.0
+
+package client
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestWaitForVaultSync(t *testing.T) {
+	var calls atomic.Int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if calls.Add(1) < 3 {
+			_, _ = w.Write([]byte(`{"id":2,"organization_id":1,"name":"v","sync_status":"pending"}`))
+			return
+		}
+		_, _ = w.Write([]byte(`{"id":2,"organization_id":1,"name":"v","sync_status":"synced"}`))
+	}))
+	defer srv.Close()
+
+	// Polls must not be answered from the read cache.
+	c := New(Config{Endpoint: srv.URL})
+	vault, err := c.WaitForVaultSync(context.Background(), "1", "2", time.Millisecond)
+	if err != nil {
+		t.Fatalf("WaitForVaultSync: %v", err)
+	}
+	if vault.SyncStatus != VaultSyncSynced {
+		t.Errorf("sync_status = %q, want %q", vault.SyncStatus, VaultSyncSynced)
+	}
+	if got := calls.Load(); got != 3 {
+		t.Errorf("calls = %d, want 3", got)
+	}
+}
+
+func TestWaitForVaultSyncTimesOut(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"id":2,"organization_id":1,"name":"v","sync_status":"pending"}`))
+	}))
+	defer srv.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	c := New(Config{Endpoint: srv.URL})
+	vault, err := c.WaitForVaultSync(ctx, "1", "2", 10*time.Millisecond)
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("err = %v, want deadline exceeded", err)
+	}
+	if vault == nil || vault.SyncStatus != VaultSyncPending {
+		t.Errorf("vault = %+v, want last pending read", vault)
+	}
+}
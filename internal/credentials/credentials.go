@@ -0,0 +1,123 @@
+// Copyright 2025 InferaDB
+// SPDX-License-Identifier: Apache-2.0
+
+// Package credentials reads the shared InferaDB credentials file written by
+// `inferadb login`.
+//
+// The file holds one section per named profile:
+//
+//	[default]
+//	endpoint      = https://api.inferadb.com
+//	session_token = ...
+//
+//	[staging]
+//	endpoint      = https://api.staging.inferadb.com
+//	session_token = ...
+//
+// Blank lines and lines starting with # or ; are ignored.
+package credentials
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// DefaultProfile is the profile used when none is selected.
+const DefaultProfile = "default"
+
+// ErrProfileNotFound is returned by LoadProfile when the file has no section
+// for the requested profile.
+var ErrProfileNotFound = errors.New("profile not found")
+
+// Profile holds the settings of one named profile.
+type Profile struct {
+	Name         string
+	Endpoint     string
+	SessionToken string
+}
+
+// DefaultPath returns the location of the shared credentials file,
+// ~/.inferadb/credentials.
+func DefaultPath() (string, error) {
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return "", fmt.Errorf("failed to locate home directory: %w", err)
+	}
+	return filepath.Join(home, ".inferadb", "credentials"), nil
+}
+
+// LoadProfile reads the credentials file at path and returns the named profile.
+func LoadProfile(path, name string) (*Profile, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = f.Close() }()
+
+	profiles, err := Parse(f)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse %s: %w", path, err)
+	}
+
+	profile, ok := profiles[name]
+	if !ok {
+		return nil, fmt.Errorf("%w: %q in %s", ErrProfileNotFound, name, path)
+	}
+	return profile, nil
+}
+
+// Parse reads every profile of a credentials file, keyed by name.
+func Parse(r io.Reader) (map[string]*Profile, error) {
+	profiles := make(map[string]*Profile)
+	var current *Profile
+
+	scanner := bufio.NewScanner(r)
+	for lineNo := 1; scanner.Scan(); lineNo++ {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") || strings.HasPrefix(line, ";") {
+			continue
+		}
+
+		if strings.HasPrefix(line, "[") {
+			if !strings.HasSuffix(line, "]") {
+				return nil, fmt.Errorf("line %d: unterminated section header", lineNo)
+			}
+			name := strings.TrimSpace(line[1 : len(line)-1])
+			if name == "" {
+				return nil, fmt.Errorf("line %d: empty profile name", lineNo)
+			}
+			current = profiles[name]
+			if current == nil {
+				current = &Profile{Name: name}
+				profiles[name] = current
+			}
+			continue
+		}
+
+		key, value, ok := strings.Cut(line, "=")
+		if !ok {
+			return nil, fmt.Errorf("line %d: expected key = value", lineNo)
+		}
+		if current == nil {
+			return nil, fmt.Errorf("line %d: setting outside of a profile section", lineNo)
+		}
+
+		value = strings.TrimSpace(value)
+		switch strings.TrimSpace(key) {
+		case "endpoint":
+			current.Endpoint = value
+		case "session_token":
+			current.SessionToken = value
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+
+	return profiles, nil
+}
@@ -0,0 +1,79 @@
+// Copyright 2025 InferaDB
+// SPDX-License-Identifier: Apache-2.0
+
+package credentials
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+const testFile = `
+# written by inferadb login
+[default]
+endpoint = https://api.inferadb.com
+session_token = prod-token
+
+[staging]
+endpoint      = https://api.staging.inferadb.com
+session_token = staging-token
+region        = ignored
+`
+
+func TestParse(t *testing.T) {
+	profiles, err := Parse(strings.NewReader(testFile))
+	if err != nil {
+		t.Fatalf("Parse: %v", err)
+	}
+
+	want := map[string]Profile{
+		"default": {Name: "default", Endpoint: "https://api.inferadb.com", SessionToken: "prod-token"},
+		"staging": {Name: "staging", Endpoint: "https://api.staging.inferadb.com", SessionToken: "staging-token"},
+	}
+	if len(profiles) != len(want) {
+		t.Fatalf("got %d profiles, want %d", len(profiles), len(want))
+	}
+	for name, w := range want {
+		if got := profiles[name]; got == nil || *got != w {
+			t.Errorf("profile %s = %+v, want %+v", name, got, w)
+		}
+	}
+}
+
+func TestParseRejectsMalformedFiles(t *testing.T) {
+	for _, input := range []string{
+		"session_token = orphan",
+		"[default\nendpoint = x",
+		"[]",
+		"[default]\nnot a setting",
+	} {
+		if _, err := Parse(strings.NewReader(input)); err == nil {
+			t.Errorf("Parse(%q): expected error", input)
+		}
+	}
+}
+
+func TestLoadProfile(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "credentials")
+	if err := os.WriteFile(path, []byte(testFile), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	profile, err := LoadProfile(path, "staging")
+	if err != nil {
+		t.Fatalf("LoadProfile: %v", err)
+	}
+	if profile.SessionToken != "staging-token" {
+		t.Errorf("SessionToken = %q, want staging-token", profile.SessionToken)
+	}
+
+	if _, err := LoadProfile(path, "missing"); !errors.Is(err, ErrProfileNotFound) {
+		t.Errorf("LoadProfile(missing) = %v, want ErrProfileNotFound", err)
+	}
+	if _, err := LoadProfile(filepath.Join(t.TempDir(), "none"), DefaultProfile); !errors.Is(err, os.ErrNotExist) {
+		t.Errorf("LoadProfile(no file) = %v, want os.ErrNotExist", err)
+	}
+}
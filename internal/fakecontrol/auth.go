@@ -0,0 +1,132 @@
+// Copyright 2025 InferaDB
+// SPDX-License-Identifier: Apache-2.0
+
+package fakecontrol
+
+import (
+	"crypto/ed25519"
+	"crypto/rand"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strings"
+	"time"
+)
+
+const (
+	clientCredentialsGrantType = "client_credentials"
+	tokenExchangeGrantType     = "urn:ietf:params:oauth:grant-type:token-exchange"
+	clientAssertionType        = "urn:ietf:params:oauth:client-assertion-type:jwt-bearer"
+)
+
+// authenticated reports whether r carries the session cookie or an access
+// token issued by the token endpoint.
+func (s *Server) authenticated(r *http.Request) bool {
+	if cookie, err := r.Cookie("infera_session"); err == nil && cookie.Value == s.SessionToken {
+		return true
+	}
+	token, ok := strings.CutPrefix(r.Header.Get("Authorization"), "Bearer ")
+	return ok && s.accessTokens[token]
+}
+
+// issueToken implements the token endpoint for client assertions signed
+// with a certificate's key and for token exchange. Exchanged subject tokens
+// are accepted as long as they are not empty.
+func (s *Server) issueToken(w http.ResponseWriter, r *http.Request) {
+	var req struct {
+		GrantType           string `json:"grant_type"`
+		ClientAssertionType string `json:"client_assertion_type"`
+		ClientAssertion     string `json:"client_assertion"`
+		SubjectToken        string `json:"subject_token"`
+	}
+	if !decode(w, r, &req) {
+		return
+	}
+
+	switch req.GrantType {
+	case clientCredentialsGrantType:
+		if req.ClientAssertionType != clientAssertionType {
+			writeError(w, http.StatusBadRequest, "invalid_request", "unsupported client assertion type")
+			return
+		}
+		if err := s.verifyAssertion(req.ClientAssertion, s.URL+r.URL.Path); err != nil {
+			writeError(w, http.StatusUnauthorized, "invalid_client", err.Error())
+			return
+		}
+	case tokenExchangeGrantType:
+		if req.SubjectToken == "" {
+			writeError(w, http.StatusBadRequest, "invalid_request", "subject_token is required")
+			return
+		}
+	default:
+		writeError(w, http.StatusBadRequest, "unsupported_grant_type", "unsupported grant type")
+		return
+	}
+
+	token := make([]byte, 16)
+	_, _ = rand.Read(token)
+	accessToken := hex.EncodeToString(token)
+	s.accessTokens[accessToken] = true
+
+	writeJSON(w, http.StatusOK, map[string]interface{}{
+		"access_token": accessToken,
+		"token_type":   "Bearer",
+		"expires_in":   3600,
+	})
+}
+
+// verifyAssertion checks a client assertion JWT against the active
+// certificate named by its kid header.
+func (s *Server) verifyAssertion(assertion, audience string) error {
+	parts := strings.Split(assertion, ".")
+	if len(parts) != 3 {
+		return errors.New("malformed client assertion")
+	}
+
+	var header struct {
+		Alg string `json:"alg"`
+		KID string `json:"kid"`
+	}
+	var claims struct {
+		Iss string `json:"iss"`
+		Sub string `json:"sub"`
+		Aud string `json:"aud"`
+		Exp int64  `json:"exp"`
+	}
+	for i, v := range []interface{}{&header, &claims} {
+		data, err := base64.RawURLEncoding.DecodeString(parts[i])
+		if err != nil {
+			return errors.New("malformed client assertion")
+		}
+		if err := json.Unmarshal(data, v); err != nil {
+			return errors.New("malformed client assertion")
+		}
+	}
+	signature, err := base64.RawURLEncoding.DecodeString(parts[2])
+	if err != nil {
+		return errors.New("malformed client assertion")
+	}
+
+	cert, ok := s.certificateByKID(header.KID)
+	if !ok || !cert.IsActive {
+		return errors.New("unknown or revoked certificate")
+	}
+	if header.Alg != "EdDSA" || !ed25519.Verify(cert.publicKey, []byte(parts[0]+"."+parts[1]), signature) {
+		return errors.New("invalid assertion signature")
+	}
+	if claims.Iss != cert.ClientID || claims.Sub != cert.ClientID {
+		return errors.New("assertion was not issued by the certificate's client")
+	}
+	if claims.Aud != audience {
+		return errors.New("assertion audience does not match the token endpoint")
+	}
+	if time.Now().Unix() > claims.Exp {
+		return errors.New("assertion has expired")
+	}
+	if c, ok := s.clients.get(cert.ClientID); !ok || !c.IsActive {
+		return errors.New("client is deactivated")
+	}
+	return nil
+}
@@ -0,0 +1,169 @@
+// Copyright 2025 InferaDB
+// SPDX-License-Identifier: Apache-2.0
+
+package fakecontrol
+
+import (
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/x509"
+	"encoding/hex"
+	"encoding/pem"
+	"net/http"
+	"strconv"
+)
+
+type certificate struct {
+	ID              string  `json:"id"`
+	ClientID        string  `json:"client_id"`
+	Name            string  `json:"name"`
+	KID             string  `json:"kid"`
+	PublicKeyPEM    string  `json:"public_key_pem"`
+	IsActive        bool    `json:"is_active"`
+	CreatedAt       string  `json:"created_at"`
+	RevokedAt       *string `json:"revoked_at,omitempty"`
+	RevokedByUserID *string `json:"revoked_by_user_id,omitempty"`
+
+	publicKey ed25519.PublicKey
+}
+
+// certificate returns the certificate of c named by the {cert} path segment,
+// answering 404 when it does not exist.
+func (s *Server) certificate(w http.ResponseWriter, r *http.Request, c *apiClient) (*certificate, bool) {
+	id := r.PathValue("cert")
+	cert, ok := s.certs.get(id)
+	if !ok || cert.ClientID != c.key() {
+		writeNotFound(w, "certificate", id)
+		return nil, false
+	}
+	return cert, true
+}
+
+// certificateByKID returns the certificate with the given key ID.
+func (s *Server) certificateByKID(kid string) (*certificate, bool) {
+	certs := s.certs.list(func(cert *certificate) bool { return cert.KID == kid })
+	if len(certs) == 0 {
+		return nil, false
+	}
+	return certs[0], true
+}
+
+func (s *Server) createCertificate(w http.ResponseWriter, r *http.Request) {
+	org, ok := s.organization(w, r)
+	if !ok {
+		return
+	}
+	c, ok := s.client(w, r, org)
+	if !ok {
+		return
+	}
+
+	var req struct {
+		Name string `json:"name"`
+	}
+	if !decode(w, r, &req) {
+		return
+	}
+	if req.Name == "" {
+		writeError(w, http.StatusBadRequest, "VALIDATION_ERROR", "name is required")
+		return
+	}
+
+	pub, priv, err := ed25519.GenerateKey(rand.Reader)
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, "INTERNAL", err.Error())
+		return
+	}
+	privDER, _ := x509.MarshalPKCS8PrivateKey(priv)
+	pubDER, _ := x509.MarshalPKIXPublicKey(pub)
+	kid := make([]byte, 8)
+	_, _ = rand.Read(kid)
+
+	cert := &certificate{
+		ID:           strconv.FormatInt(s.nextID(), 10),
+		ClientID:     c.key(),
+		Name:         req.Name,
+		KID:          hex.EncodeToString(kid),
+		PublicKeyPEM: string(pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: pubDER})),
+		IsActive:     true,
+		CreatedAt:    now(),
+		publicKey:    pub,
+	}
+	s.certs.put(cert.ID, cert)
+
+	// The private key is only ever returned by this response.
+	writeJSON(w, http.StatusCreated, struct {
+		*certificate
+		PrivateKeyPEM string `json:"private_key_pem"`
+	}{cert, string(pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: privDER}))})
+}
+
+func (s *Server) listCertificates(w http.ResponseWriter, r *http.Request) {
+	org, ok := s.organization(w, r)
+	if !ok {
+		return
+	}
+	c, ok := s.client(w, r, org)
+	if !ok {
+		return
+	}
+	writeList(w, s.certs.list(func(cert *certificate) bool { return cert.ClientID == c.key() }))
+}
+
+func (s *Server) getCertificate(w http.ResponseWriter, r *http.Request) {
+	org, ok := s.organization(w, r)
+	if !ok {
+		return
+	}
+	c, ok := s.client(w, r, org)
+	if !ok {
+		return
+	}
+	cert, ok := s.certificate(w, r, c)
+	if !ok {
+		return
+	}
+	writeJSON(w, http.StatusOK, cert)
+}
+
+func (s *Server) revokeCertificate(w http.ResponseWriter, r *http.Request) {
+	org, ok := s.organization(w, r)
+	if !ok {
+		return
+	}
+	c, ok := s.client(w, r, org)
+	if !ok {
+		return
+	}
+	cert, ok := s.certificate(w, r, c)
+	if !ok {
+		return
+	}
+	if cert.RevokedAt != nil {
+		writeError(w, http.StatusConflict, "CONFLICT", "the certificate is already revoked")
+		return
+	}
+
+	revokedAt, revokedBy := now(), s.UserID
+	cert.IsActive = false
+	cert.RevokedAt = &revokedAt
+	cert.RevokedByUserID = &revokedBy
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (s *Server) deleteCertificate(w http.ResponseWriter, r *http.Request) {
+	org, ok := s.organization(w, r)
+	if !ok {
+		return
+	}
+	c, ok := s.client(w, r, org)
+	if !ok {
+		return
+	}
+	cert, ok := s.certificate(w, r, c)
+	if !ok {
+		return
+	}
+	s.certs.delete(cert.ID)
+	w.WriteHeader(http.StatusNoContent)
+}
@@ -0,0 +1,171 @@
+// Copyright 2025 InferaDB
+// SPDX-License-Identifier: Apache-2.0
+
+package fakecontrol
+
+import (
+	"net/http"
+	"strconv"
+)
+
+type apiClient struct {
+	ID             int64  `json:"id"`
+	OrganizationID int64  `json:"organization_id"`
+	VaultID        int64  `json:"vault_id"`
+	Name           string `json:"name"`
+	Description    string `json:"description,omitempty"`
+	IsActive       bool   `json:"is_active"`
+	CreatedAt      string `json:"created_at"`
+	UpdatedAt      string `json:"updated_at"`
+}
+
+func (c *apiClient) key() string {
+	return strconv.FormatInt(c.ID, 10)
+}
+
+// client returns the client of org named by the {client} path segment,
+// answering 404 when it does not exist.
+func (s *Server) client(w http.ResponseWriter, r *http.Request, org *organization) (*apiClient, bool) {
+	id := r.PathValue("client")
+	c, ok := s.clients.get(id)
+	if !ok || c.OrganizationID != org.ID {
+		writeNotFound(w, "client", id)
+		return nil, false
+	}
+	return c, true
+}
+
+// clientVault resolves the vault a client is assigned to, answering 404 when
+// org has no such vault.
+func (s *Server) clientVault(w http.ResponseWriter, org *organization, id string) (*vault, bool) {
+	v, ok := s.vaults.get(id)
+	if !ok || v.OrganizationID != org.ID {
+		writeNotFound(w, "vault", id)
+		return nil, false
+	}
+	return v, true
+}
+
+func (s *Server) createClient(w http.ResponseWriter, r *http.Request) {
+	org, ok := s.organization(w, r)
+	if !ok {
+		return
+	}
+
+	var req struct {
+		Name    string `json:"name"`
+		VaultID string `json:"vault_id"`
+	}
+	if !decode(w, r, &req) {
+		return
+	}
+	if req.Name == "" || req.VaultID == "" {
+		writeError(w, http.StatusBadRequest, "VALIDATION_ERROR", "name and vault_id are required")
+		return
+	}
+	v, ok := s.clientVault(w, org, req.VaultID)
+	if !ok {
+		return
+	}
+
+	c := &apiClient{
+		ID:             s.nextID(),
+		OrganizationID: org.ID,
+		VaultID:        v.ID,
+		Name:           req.Name,
+		IsActive:       true,
+		CreatedAt:      now(),
+	}
+	c.UpdatedAt = c.CreatedAt
+	s.clients.put(c.key(), c)
+
+	writeJSON(w, http.StatusCreated, map[string]interface{}{"client": c})
+}
+
+func (s *Server) listClients(w http.ResponseWriter, r *http.Request) {
+	org, ok := s.organization(w, r)
+	if !ok {
+		return
+	}
+	writePage(w, r, "clients", s.clients.list(func(c *apiClient) bool { return c.OrganizationID == org.ID }))
+}
+
+func (s *Server) getClient(w http.ResponseWriter, r *http.Request) {
+	org, ok := s.organization(w, r)
+	if !ok {
+		return
+	}
+	c, ok := s.client(w, r, org)
+	if !ok {
+		return
+	}
+	writeJSON(w, http.StatusOK, map[string]interface{}{"client": c})
+}
+
+func (s *Server) updateClient(w http.ResponseWriter, r *http.Request) {
+	org, ok := s.organization(w, r)
+	if !ok {
+		return
+	}
+	c, ok := s.client(w, r, org)
+	if !ok {
+		return
+	}
+
+	var req struct {
+		Name    string `json:"name"`
+		VaultID string `json:"vault_id"`
+	}
+	if !decode(w, r, &req) {
+		return
+	}
+	if req.VaultID != "" {
+		v, ok := s.clientVault(w, org, req.VaultID)
+		if !ok {
+			return
+		}
+		c.VaultID = v.ID
+	}
+	if req.Name != "" {
+		c.Name = req.Name
+	}
+	c.UpdatedAt = now()
+
+	writeJSON(w, http.StatusOK, map[string]interface{}{"client": c})
+}
+
+func (s *Server) deactivateClient(w http.ResponseWriter, r *http.Request) {
+	org, ok := s.organization(w, r)
+	if !ok {
+		return
+	}
+	c, ok := s.client(w, r, org)
+	if !ok {
+		return
+	}
+	c.IsActive = false
+	c.UpdatedAt = now()
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (s *Server) deleteClient(w http.ResponseWriter, r *http.Request) {
+	org, ok := s.organization(w, r)
+	if !ok {
+		return
+	}
+	c, ok := s.client(w, r, org)
+	if !ok {
+		return
+	}
+	s.removeClient(c)
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// removeClient deletes a client together with its certificates.
+func (s *Server) removeClient(c *apiClient) {
+	clientID := c.key()
+	for _, cert := range s.certs.list(func(cert *certificate) bool { return cert.ClientID == clientID }) {
+		s.certs.delete(cert.ID)
+	}
+	s.clients.delete(clientID)
+}
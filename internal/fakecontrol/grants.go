@@ -0,0 +1,267 @@
+// Copyright 2025 InferaDB
+// SPDX-License-Identifier: Apache-2.0
+
+package fakecontrol
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+)
+
+// vaultRoles are the roles a vault grant can confer.
+var vaultRoles = map[string]bool{"reader": true, "writer": true, "manager": true, "admin": true}
+
+type vaultUserGrant struct {
+	ID              string `json:"id"`
+	VaultID         string `json:"vault_id"`
+	UserID          string `json:"user_id"`
+	Role            string `json:"role"`
+	GrantedAt       string `json:"granted_at"`
+	GrantedByUserID string `json:"granted_by_user_id"`
+
+	version int
+}
+
+func (g *vaultUserGrant) etag() string {
+	return etagOf(g.ID, g.version)
+}
+
+type vaultTeamGrant struct {
+	ID              string `json:"id"`
+	VaultID         string `json:"vault_id"`
+	TeamID          string `json:"team_id"`
+	Role            string `json:"role"`
+	GrantedAt       string `json:"granted_at"`
+	GrantedByUserID string `json:"granted_by_user_id"`
+
+	version int
+}
+
+func (g *vaultTeamGrant) etag() string {
+	return etagOf(g.ID, g.version)
+}
+
+// grantVault resolves the organization and vault of a grant route.
+func (s *Server) grantVault(w http.ResponseWriter, r *http.Request) (*organization, *vault, bool) {
+	org, ok := s.organization(w, r)
+	if !ok {
+		return nil, nil, false
+	}
+	v, ok := s.vault(w, r, org)
+	if !ok {
+		return nil, nil, false
+	}
+	return org, v, true
+}
+
+// checkVaultRole answers 400 when role is not a vault role.
+func checkVaultRole(w http.ResponseWriter, role string) bool {
+	if vaultRoles[role] {
+		return true
+	}
+	writeError(w, http.StatusBadRequest, "VALIDATION_ERROR", fmt.Sprintf("unknown vault role %q", role))
+	return false
+}
+
+func (s *Server) userGrant(w http.ResponseWriter, r *http.Request, v *vault) (*vaultUserGrant, bool) {
+	id := r.PathValue("grant")
+	g, ok := s.userGrants.get(id)
+	if !ok || g.VaultID != v.key() {
+		writeNotFound(w, "user grant", id)
+		return nil, false
+	}
+	return g, true
+}
+
+func (s *Server) createUserGrant(w http.ResponseWriter, r *http.Request) {
+	_, v, ok := s.grantVault(w, r)
+	if !ok {
+		return
+	}
+
+	var req struct {
+		UserID string `json:"user_id"`
+		Role   string `json:"role"`
+	}
+	if !decode(w, r, &req) || !checkVaultRole(w, req.Role) {
+		return
+	}
+	if _, ok := s.users[req.UserID]; !ok {
+		writeNotFound(w, "user", req.UserID)
+		return
+	}
+	if len(s.userGrants.list(func(g *vaultUserGrant) bool { return g.VaultID == v.key() && g.UserID == req.UserID })) > 0 {
+		writeError(w, http.StatusConflict, "CONFLICT", fmt.Sprintf("user %s already has a grant on the vault", req.UserID))
+		return
+	}
+
+	g := &vaultUserGrant{
+		ID:              strconv.FormatInt(s.nextID(), 10),
+		VaultID:         v.key(),
+		UserID:          req.UserID,
+		Role:            req.Role,
+		GrantedAt:       now(),
+		GrantedByUserID: s.UserID,
+		version:         1,
+	}
+	s.userGrants.put(g.ID, g)
+
+	writeObject(w, http.StatusCreated, g.etag(), g)
+}
+
+func (s *Server) listUserGrants(w http.ResponseWriter, r *http.Request) {
+	_, v, ok := s.grantVault(w, r)
+	if !ok {
+		return
+	}
+	writeList(w, s.userGrants.list(func(g *vaultUserGrant) bool { return g.VaultID == v.key() }))
+}
+
+func (s *Server) getUserGrant(w http.ResponseWriter, r *http.Request) {
+	_, v, ok := s.grantVault(w, r)
+	if !ok {
+		return
+	}
+	g, ok := s.userGrant(w, r, v)
+	if !ok {
+		return
+	}
+	writeObject(w, http.StatusOK, g.etag(), g)
+}
+
+func (s *Server) updateUserGrant(w http.ResponseWriter, r *http.Request) {
+	_, v, ok := s.grantVault(w, r)
+	if !ok {
+		return
+	}
+	g, ok := s.userGrant(w, r, v)
+	if !ok || !checkIfMatch(w, r, g.etag()) {
+		return
+	}
+
+	var req struct {
+		Role string `json:"role"`
+	}
+	if !decode(w, r, &req) || !checkVaultRole(w, req.Role) {
+		return
+	}
+	g.Role = req.Role
+	g.version++
+
+	writeObject(w, http.StatusOK, g.etag(), g)
+}
+
+func (s *Server) deleteUserGrant(w http.ResponseWriter, r *http.Request) {
+	_, v, ok := s.grantVault(w, r)
+	if !ok {
+		return
+	}
+	g, ok := s.userGrant(w, r, v)
+	if !ok {
+		return
+	}
+	s.userGrants.delete(g.ID)
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (s *Server) teamGrant(w http.ResponseWriter, r *http.Request, v *vault) (*vaultTeamGrant, bool) {
+	id := r.PathValue("grant")
+	g, ok := s.teamGrants.get(id)
+	if !ok || g.VaultID != v.key() {
+		writeNotFound(w, "team grant", id)
+		return nil, false
+	}
+	return g, true
+}
+
+func (s *Server) createTeamGrant(w http.ResponseWriter, r *http.Request) {
+	org, v, ok := s.grantVault(w, r)
+	if !ok {
+		return
+	}
+
+	var req struct {
+		TeamID string `json:"team_id"`
+		Role   string `json:"role"`
+	}
+	if !decode(w, r, &req) || !checkVaultRole(w, req.Role) {
+		return
+	}
+	if t, ok := s.teams.get(req.TeamID); !ok || t.OrganizationID != org.ID {
+		writeNotFound(w, "team", req.TeamID)
+		return
+	}
+	if len(s.teamGrants.list(func(g *vaultTeamGrant) bool { return g.VaultID == v.key() && g.TeamID == req.TeamID })) > 0 {
+		writeError(w, http.StatusConflict, "CONFLICT", fmt.Sprintf("team %s already has a grant on the vault", req.TeamID))
+		return
+	}
+
+	g := &vaultTeamGrant{
+		ID:              strconv.FormatInt(s.nextID(), 10),
+		VaultID:         v.key(),
+		TeamID:          req.TeamID,
+		Role:            req.Role,
+		GrantedAt:       now(),
+		GrantedByUserID: s.UserID,
+		version:         1,
+	}
+	s.teamGrants.put(g.ID, g)
+
+	writeObject(w, http.StatusCreated, g.etag(), g)
+}
+
+func (s *Server) listTeamGrants(w http.ResponseWriter, r *http.Request) {
+	_, v, ok := s.grantVault(w, r)
+	if !ok {
+		return
+	}
+	writeList(w, s.teamGrants.list(func(g *vaultTeamGrant) bool { return g.VaultID == v.key() }))
+}
+
+func (s *Server) getTeamGrant(w http.ResponseWriter, r *http.Request) {
+	_, v, ok := s.grantVault(w, r)
+	if !ok {
+		return
+	}
+	g, ok := s.teamGrant(w, r, v)
+	if !ok {
+		return
+	}
+	writeObject(w, http.StatusOK, g.etag(), g)
+}
+
+func (s *Server) updateTeamGrant(w http.ResponseWriter, r *http.Request) {
+	_, v, ok := s.grantVault(w, r)
+	if !ok {
+		return
+	}
+	g, ok := s.teamGrant(w, r, v)
+	if !ok || !checkIfMatch(w, r, g.etag()) {
+		return
+	}
+
+	var req struct {
+		Role string `json:"role"`
+	}
+	if !decode(w, r, &req) || !checkVaultRole(w, req.Role) {
+		return
+	}
+	g.Role = req.Role
+	g.version++
+
+	writeObject(w, http.StatusOK, g.etag(), g)
+}
+
+func (s *Server) deleteTeamGrant(w http.ResponseWriter, r *http.Request) {
+	_, v, ok := s.grantVault(w, r)
+	if !ok {
+		return
+	}
+	g, ok := s.teamGrant(w, r, v)
+	if !ok {
+		return
+	}
+	s.teamGrants.delete(g.ID)
+	w.WriteHeader(http.StatusNoContent)
+}
@@ -0,0 +1,156 @@
+// Copyright 2025 InferaDB
+// SPDX-License-Identifier: Apache-2.0
+
+package fakecontrol
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+)
+
+// tiers maps the tier names accepted in requests to the API's TIER_* form.
+var tiers = map[string]string{
+	"dev": "TIER_DEV_V1",
+	"pro": "TIER_PRO_V1",
+	"max": "TIER_MAX_V1",
+}
+
+type organization struct {
+	ID          int64   `json:"id"`
+	Name        string  `json:"name"`
+	Tier        string  `json:"tier"`
+	Role        string  `json:"role"`
+	CreatedAt   string  `json:"created_at"`
+	UpdatedAt   string  `json:"updated_at"`
+	SuspendedAt *string `json:"suspended_at,omitempty"`
+
+	version int
+}
+
+func (o *organization) etag() string {
+	return etagOf(strconv.FormatInt(o.ID, 10), o.version)
+}
+
+// apiTier converts a requested tier to its TIER_* form. An empty tier
+// selects the dev tier.
+func apiTier(tier string) (string, bool) {
+	if tier == "" {
+		return tiers["dev"], true
+	}
+	if apiTier, ok := tiers[tier]; ok {
+		return apiTier, true
+	}
+	for _, apiTier := range tiers {
+		if tier == apiTier {
+			return apiTier, true
+		}
+	}
+	return "", false
+}
+
+// organization returns the organization named by the {org} path segment,
+// answering 404 when it does not exist.
+func (s *Server) organization(w http.ResponseWriter, r *http.Request) (*organization, bool) {
+	id := r.PathValue("org")
+	org, ok := s.orgs.get(id)
+	if !ok {
+		writeNotFound(w, "organization", id)
+	}
+	return org, ok
+}
+
+func (s *Server) createOrganization(w http.ResponseWriter, r *http.Request) {
+	var req struct {
+		Name string `json:"name"`
+		Tier string `json:"tier"`
+	}
+	if !decode(w, r, &req) {
+		return
+	}
+	if req.Name == "" {
+		writeError(w, http.StatusBadRequest, "VALIDATION_ERROR", "name is required")
+		return
+	}
+	tier, ok := apiTier(req.Tier)
+	if !ok {
+		writeError(w, http.StatusBadRequest, "VALIDATION_ERROR", fmt.Sprintf("unknown tier %q", req.Tier))
+		return
+	}
+
+	org := &organization{
+		ID:        s.nextID(),
+		Name:      req.Name,
+		Tier:      tier,
+		Role:      "owner",
+		CreatedAt: now(),
+		version:   1,
+	}
+	org.UpdatedAt = org.CreatedAt
+	s.orgs.put(strconv.FormatInt(org.ID, 10), org)
+
+	writeObject(w, http.StatusCreated, org.etag(), map[string]interface{}{"organization": org})
+}
+
+func (s *Server) listOrganizations(w http.ResponseWriter, r *http.Request) {
+	writePage(w, r, "organizations", s.orgs.list(func(*organization) bool { return true }))
+}
+
+func (s *Server) getOrganization(w http.ResponseWriter, r *http.Request) {
+	org, ok := s.organization(w, r)
+	if !ok {
+		return
+	}
+	writeObject(w, http.StatusOK, org.etag(), map[string]interface{}{"organization": org})
+}
+
+func (s *Server) updateOrganization(w http.ResponseWriter, r *http.Request) {
+	org, ok := s.organization(w, r)
+	if !ok || !checkIfMatch(w, r, org.etag()) {
+		return
+	}
+
+	var req struct {
+		Name string `json:"name"`
+		Tier string `json:"tier"`
+	}
+	if !decode(w, r, &req) {
+		return
+	}
+	if req.Tier != "" {
+		tier, ok := apiTier(req.Tier)
+		if !ok {
+			writeError(w, http.StatusBadRequest, "VALIDATION_ERROR", fmt.Sprintf("unknown tier %q", req.Tier))
+			return
+		}
+		org.Tier = tier
+	}
+	if req.Name != "" {
+		org.Name = req.Name
+	}
+	org.UpdatedAt = now()
+	org.version++
+
+	writeObject(w, http.StatusOK, org.etag(), map[string]interface{}{"organization": org})
+}
+
+func (s *Server) deleteOrganization(w http.ResponseWriter, r *http.Request) {
+	org, ok := s.organization(w, r)
+	if !ok {
+		return
+	}
+
+	// Deleting an organization deletes everything it contains.
+	for _, v := range s.vaults.list(func(v *vault) bool { return v.OrganizationID == org.ID }) {
+		s.removeVault(v)
+	}
+	for _, c := range s.clients.list(func(c *apiClient) bool { return c.OrganizationID == org.ID }) {
+		s.removeClient(c)
+	}
+	for _, t := range s.teams.list(func(t *team) bool { return t.OrganizationID == org.ID }) {
+		s.removeTeam(t)
+	}
+	s.orgs.delete(strconv.FormatInt(org.ID, 10))
+
+	w.WriteHeader(http.StatusNoContent)
+}
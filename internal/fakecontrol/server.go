@@ -0,0 +1,334 @@
+// Copyright 2025 InferaDB
+// SPDX-License-Identifier: Apache-2.0
+
+// Package fakecontrol is an in-memory fake of the InferaDB Control API. It
+// serves every route used by internal/client over httptest with the
+// envelopes, numeric snowflake IDs, TIER_* strings, ETags, paginated and
+// bare-array lists and 404/409/412 semantics of the real API, so the
+// provider can be tested with plain `go test` and no Docker environment. The
+// provider also serves it as the `memory://` endpoint for offline use of
+// Terraform modules.
+package fakecontrol
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sort"
+	"strconv"
+	"sync"
+	"time"
+)
+
+// DefaultSessionToken is the session token accepted when Options.SessionToken
+// is empty.
+const DefaultSessionToken = "fake-session-token"
+
+// snowflakeEpoch is the custom epoch of generated snowflake IDs
+// (2024-01-01T00:00:00Z, in milliseconds).
+const snowflakeEpoch = 1704067200000
+
+// Options configures a Server.
+type Options struct {
+	// SessionToken is the infera_session cookie value the server accepts.
+	// Defaults to DefaultSessionToken.
+	SessionToken string
+
+	// PendingVaultReads is the number of reads for which a new vault still
+	// reports sync_status "pending". Vaults are always pending in the create
+	// response; with zero, the first read reports "synced".
+	PendingVaultReads int
+}
+
+// Server is a fake Control API served over HTTP. It is safe for concurrent use.
+type Server struct {
+	// URL is the base URL of the server, for use as the provider endpoint.
+	URL string
+
+	// SessionToken is the accepted session token.
+	SessionToken string
+
+	// UserID is the ID of the user the session token belongs to. It owns
+	// every organization and is reported as the granting user.
+	UserID string
+
+	srv  *httptest.Server
+	opts Options
+
+	mu            sync.Mutex
+	lastID        int64
+	users         map[string]string
+	orgs          table[organization]
+	vaults        table[vault]
+	clients       table[apiClient]
+	certs         table[certificate]
+	teams         table[team]
+	members       table[teamMember]
+	userGrants    table[vaultUserGrant]
+	teamGrants    table[vaultTeamGrant]
+	accessTokens  map[string]bool
+	pendingVaults map[string]int
+}
+
+// New starts a Server. Call Close when done.
+func New(opts Options) *Server {
+	if opts.SessionToken == "" {
+		opts.SessionToken = DefaultSessionToken
+	}
+
+	s := &Server{
+		SessionToken:  opts.SessionToken,
+		opts:          opts,
+		users:         map[string]string{},
+		accessTokens:  map[string]bool{},
+		pendingVaults: map[string]int{},
+	}
+	s.UserID = s.AddUser("owner@example.com")
+
+	mux := http.NewServeMux()
+	s.routes(mux)
+	s.srv = httptest.NewServer(mux)
+	s.URL = s.srv.URL
+	return s
+}
+
+// Close shuts the server down.
+func (s *Server) Close() {
+	s.srv.Close()
+}
+
+// AddUser registers a user that can be added to teams and granted vault
+// access, and returns its ID.
+func (s *Server) AddUser(email string) string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	id := strconv.FormatInt(s.nextID(), 10)
+	s.users[id] = email
+	return id
+}
+
+func (s *Server) routes(mux *http.ServeMux) {
+	mux.HandleFunc("POST /v1/auth/token", s.locked(s.issueToken))
+
+	s.handle(mux, "POST /v1/organizations", s.createOrganization)
+	s.handle(mux, "GET /v1/organizations", s.listOrganizations)
+	s.handle(mux, "GET /v1/organizations/{org}", s.getOrganization)
+	s.handle(mux, "PATCH /v1/organizations/{org}", s.updateOrganization)
+	s.handle(mux, "DELETE /v1/organizations/{org}", s.deleteOrganization)
+
+	s.handle(mux, "POST /v1/organizations/{org}/vaults", s.createVault)
+	s.handle(mux, "GET /v1/organizations/{org}/vaults", s.listVaults)
+	s.handle(mux, "GET /v1/organizations/{org}/vaults/{vault}", s.getVault)
+	s.handle(mux, "PATCH /v1/organizations/{org}/vaults/{vault}", s.updateVault)
+	s.handle(mux, "DELETE /v1/organizations/{org}/vaults/{vault}", s.deleteVault)
+
+	s.handle(mux, "POST /v1/organizations/{org}/clients", s.createClient)
+	s.handle(mux, "GET /v1/organizations/{org}/clients", s.listClients)
+	s.handle(mux, "GET /v1/organizations/{org}/clients/{client}", s.getClient)
+	s.handle(mux, "PATCH /v1/organizations/{org}/clients/{client}", s.updateClient)
+	s.handle(mux, "DELETE /v1/organizations/{org}/clients/{client}", s.deleteClient)
+	s.handle(mux, "POST /v1/organizations/{org}/clients/{client}/deactivate", s.deactivateClient)
+
+	s.handle(mux, "POST /v1/organizations/{org}/clients/{client}/certificates", s.createCertificate)
+	s.handle(mux, "GET /v1/organizations/{org}/clients/{client}/certificates", s.listCertificates)
+	s.handle(mux, "GET /v1/organizations/{org}/clients/{client}/certificates/{cert}", s.getCertificate)
+	s.handle(mux, "DELETE /v1/organizations/{org}/clients/{client}/certificates/{cert}", s.deleteCertificate)
+	s.handle(mux, "POST /v1/organizations/{org}/clients/{client}/certificates/{cert}/revoke", s.revokeCertificate)
+
+	s.handle(mux, "POST /v1/organizations/{org}/teams", s.createTeam)
+	s.handle(mux, "GET /v1/organizations/{org}/teams", s.listTeams)
+	s.handle(mux, "GET /v1/organizations/{org}/teams/{team}", s.getTeam)
+	s.handle(mux, "PATCH /v1/organizations/{org}/teams/{team}", s.updateTeam)
+	s.handle(mux, "DELETE /v1/organizations/{org}/teams/{team}", s.deleteTeam)
+
+	s.handle(mux, "POST /v1/organizations/{org}/teams/{team}/members", s.addTeamMember)
+	s.handle(mux, "GET /v1/organizations/{org}/teams/{team}/members", s.listTeamMembers)
+	s.handle(mux, "GET /v1/organizations/{org}/teams/{team}/members/{member}", s.getTeamMember)
+	s.handle(mux, "PATCH /v1/organizations/{org}/teams/{team}/members/{member}", s.updateTeamMember)
+	s.handle(mux, "DELETE /v1/organizations/{org}/teams/{team}/members/{member}", s.removeTeamMember)
+
+	s.handle(mux, "POST /v1/organizations/{org}/vaults/{vault}/user-grants", s.createUserGrant)
+	s.handle(mux, "GET /v1/organizations/{org}/vaults/{vault}/user-grants", s.listUserGrants)
+	s.handle(mux, "GET /v1/organizations/{org}/vaults/{vault}/user-grants/{grant}", s.getUserGrant)
+	s.handle(mux, "PATCH /v1/organizations/{org}/vaults/{vault}/user-grants/{grant}", s.updateUserGrant)
+	s.handle(mux, "DELETE /v1/organizations/{org}/vaults/{vault}/user-grants/{grant}", s.deleteUserGrant)
+
+	s.handle(mux, "POST /v1/organizations/{org}/vaults/{vault}/team-grants", s.createTeamGrant)
+	s.handle(mux, "GET /v1/organizations/{org}/vaults/{vault}/team-grants", s.listTeamGrants)
+	s.handle(mux, "GET /v1/organizations/{org}/vaults/{vault}/team-grants/{grant}", s.getTeamGrant)
+	s.handle(mux, "PATCH /v1/organizations/{org}/vaults/{vault}/team-grants/{grant}", s.updateTeamGrant)
+	s.handle(mux, "DELETE /v1/organizations/{org}/vaults/{vault}/team-grants/{grant}", s.deleteTeamGrant)
+
+	mux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		writeError(w, http.StatusNotFound, "NOT_FOUND", fmt.Sprintf("no route for %s %s", r.Method, r.URL.Path))
+	})
+}
+
+// handle registers an authenticated route.
+func (s *Server) handle(mux *http.ServeMux, pattern string, h http.HandlerFunc) {
+	mux.HandleFunc(pattern, s.locked(func(w http.ResponseWriter, r *http.Request) {
+		if !s.authenticated(r) {
+			writeError(w, http.StatusUnauthorized, "UNAUTHORIZED", "missing or invalid credentials")
+			return
+		}
+		h(w, r)
+	}))
+}
+
+// locked serializes handlers, which share the in-memory state.
+func (s *Server) locked(h http.HandlerFunc) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		s.mu.Lock()
+		defer s.mu.Unlock()
+		h(w, r)
+	}
+}
+
+// nextID returns a new snowflake ID: milliseconds since snowflakeEpoch in
+// the upper bits and a sequence number in the lower 22 bits. The epoch,
+// 2024-01-01, and the layout are this fake's own choice; the real service
+// documents neither, so IDs are only ever compared, never decoded.
+func (s *Server) nextID() int64 {
+	id := (time.Now().UnixMilli() - snowflakeEpoch) << 22
+	if id <= s.lastID {
+		id = s.lastID + 1
+	}
+	s.lastID = id
+	return id
+}
+
+// now returns the current time in the API's timestamp format.
+func now() string {
+	return time.Now().UTC().Format(time.RFC3339Nano)
+}
+
+// checkIfMatch rejects a conditional update whose If-Match does not match
+// the object's current ETag with 412. It reports whether the update may
+// proceed.
+func checkIfMatch(w http.ResponseWriter, r *http.Request, etag string) bool {
+	ifMatch := r.Header.Get("If-Match")
+	if ifMatch == "" || ifMatch == "*" || ifMatch == etag {
+		return true
+	}
+	writeError(w, http.StatusPreconditionFailed, "PRECONDITION_FAILED", "the object was modified since it was read")
+	return false
+}
+
+// etagOf returns the ETag of version of the object id.
+func etagOf(id string, version int) string {
+	return fmt.Sprintf(`"%s-%d"`, id, version)
+}
+
+// decode reads a JSON request body into v, answering 400 on failure. It
+// reports whether decoding succeeded.
+func decode(w http.ResponseWriter, r *http.Request, v interface{}) bool {
+	if err := json.NewDecoder(r.Body).Decode(v); err != nil {
+		writeError(w, http.StatusBadRequest, "VALIDATION_ERROR", fmt.Sprintf("invalid request body: %s", err))
+		return false
+	}
+	return true
+}
+
+func writeJSON(w http.ResponseWriter, status int, v interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(v)
+}
+
+// writeObject writes an object and its ETag.
+func writeObject(w http.ResponseWriter, status int, etag string, v interface{}) {
+	w.Header().Set("ETag", etag)
+	writeJSON(w, status, v)
+}
+
+func writeError(w http.ResponseWriter, status int, code, message string) {
+	writeJSON(w, status, map[string]string{
+		"error": message,
+		"code":  code,
+	})
+}
+
+func writeNotFound(w http.ResponseWriter, kind, id string) {
+	writeError(w, http.StatusNotFound, "NOT_FOUND", fmt.Sprintf("%s %s not found", kind, id))
+}
+
+// writePage writes one page of items, selected by the offset and limit
+// query parameters, in a list envelope under key.
+func writePage[T any](w http.ResponseWriter, r *http.Request, key string, items []T) {
+	offset, _ := strconv.Atoi(r.URL.Query().Get("offset"))
+	limit, err := strconv.Atoi(r.URL.Query().Get("limit"))
+	if err != nil || limit <= 0 || limit > 100 {
+		limit = 50
+	}
+	if offset < 0 || offset > len(items) {
+		offset = len(items)
+	}
+	end := min(offset+limit, len(items))
+	page := items[offset:end]
+
+	writeJSON(w, http.StatusOK, map[string]interface{}{
+		key: page,
+		"pagination": map[string]interface{}{
+			"total":    len(items),
+			"count":    len(page),
+			"offset":   offset,
+			"limit":    limit,
+			"has_more": end < len(items),
+		},
+	})
+}
+
+// writeList writes items as a bare JSON array, the shape the Control API
+// uses for the routes that are not paginated: certificates, team members
+// and vault grants.
+func writeList[T any](w http.ResponseWriter, items []T) {
+	if items == nil {
+		items = []T{}
+	}
+	writeJSON(w, http.StatusOK, items)
+}
+
+// table stores objects of one kind by ID and lists them in creation order.
+type table[T any] struct {
+	rows map[string]*T
+}
+
+func (t *table[T]) get(id string) (*T, bool) {
+	row, ok := t.rows[id]
+	return row, ok
+}
+
+func (t *table[T]) put(id string, row *T) {
+	if t.rows == nil {
+		t.rows = map[string]*T{}
+	}
+	t.rows[id] = row
+}
+
+func (t *table[T]) delete(id string) {
+	delete(t.rows, id)
+}
+
+// list returns the rows matching keep, oldest first. IDs are snowflakes, so
+// numeric order is creation order.
+func (t *table[T]) list(keep func(*T) bool) []*T {
+	ids := make([]string, 0, len(t.rows))
+	for id, row := range t.rows {
+		if keep(row) {
+			ids = append(ids, id)
+		}
+	}
+	sort.Slice(ids, func(i, j int) bool {
+		a, _ := strconv.ParseInt(ids[i], 10, 64)
+		b, _ := strconv.ParseInt(ids[j], 10, 64)
+		return a < b
+	})
+
+	rows := make([]*T, len(ids))
+	for i, id := range ids {
+		rows[i] = t.rows[id]
+	}
+	return rows
+}
@@ -0,0 +1,296 @@
+// Copyright 2025 InferaDB
+// SPDX-License-Identifier: Apache-2.0
+
+package fakecontrol
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/inferadb/terraform-provider-inferadb/internal/client"
+)
+
+func newClient(t *testing.T, opts Options) (*Server, *client.Client) {
+	t.Helper()
+
+	srv := New(opts)
+	t.Cleanup(srv.Close)
+	return srv, client.New(client.Config{Endpoint: srv.URL, SessionToken: srv.SessionToken, CacheTTL: -1})
+}
+
+func TestServerOrganizationsAndVaults(t *testing.T) {
+	_, c := newClient(t, Options{PendingVaultReads: 1})
+	ctx := context.Background()
+
+	org, err := c.CreateOrganization(ctx, client.CreateOrganizationRequest{Name: "acme", Tier: "pro"})
+	if err != nil {
+		t.Fatalf("CreateOrganization: %v", err)
+	}
+	if org.Tier != "pro" || org.ETag == "" {
+		t.Errorf("organization = %+v, want tier pro and an ETag", org)
+	}
+	if _, err := strconv.ParseInt(org.ID.String(), 10, 64); err != nil {
+		t.Errorf("organization ID %q is not numeric", org.ID)
+	}
+
+	if _, err := c.UpdateOrganization(ctx, org.ID.String(), client.UpdateOrganizationRequest{Name: "acme-2", IfMatch: `"stale"`}); !client.IsPreconditionFailed(err) {
+		t.Errorf("update with stale ETag: err = %v, want precondition failed", err)
+	}
+	org, err = c.UpdateOrganization(ctx, org.ID.String(), client.UpdateOrganizationRequest{Name: "acme-2", IfMatch: org.ETag})
+	if err != nil || org.Name != "acme-2" {
+		t.Fatalf("UpdateOrganization = %+v, %v", org, err)
+	}
+
+	vault, err := c.CreateVault(ctx, org.ID.String(), client.CreateVaultRequest{Name: "prod"})
+	if err != nil {
+		t.Fatalf("CreateVault: %v", err)
+	}
+	if vault.SyncStatus != client.VaultSyncPending {
+		t.Errorf("sync_status = %q, want pending", vault.SyncStatus)
+	}
+	if _, err := c.CreateVault(ctx, org.ID.String(), client.CreateVaultRequest{Name: "prod"}); !client.IsConflict(err) {
+		t.Errorf("duplicate vault: err = %v, want conflict", err)
+	}
+	synced, err := c.WaitForVaultSync(ctx, org.ID.String(), vault.ID.String(), time.Millisecond)
+	if err != nil || synced.SyncStatus != client.VaultSyncSynced {
+		t.Fatalf("WaitForVaultSync = %+v, %v", synced, err)
+	}
+
+	vaults, err := c.ListVaults(ctx, org.ID.String())
+	if err != nil || len(vaults) != 1 {
+		t.Fatalf("ListVaults = %v, %v", vaults, err)
+	}
+
+	if err := c.DeleteOrganization(ctx, org.ID.String()); err != nil {
+		t.Fatalf("DeleteOrganization: %v", err)
+	}
+	if _, err := c.GetVault(ctx, org.ID.String(), vault.ID.String()); !client.IsNotFound(err) {
+		t.Errorf("vault of deleted organization: err = %v, want not found", err)
+	}
+}
+
+func TestServerTeamsAndGrants(t *testing.T) {
+	srv, c := newClient(t, Options{})
+	ctx := context.Background()
+	userID := srv.AddUser("dev@example.com")
+
+	org, err := c.CreateOrganization(ctx, client.CreateOrganizationRequest{Name: "acme"})
+	if err != nil {
+		t.Fatalf("CreateOrganization: %v", err)
+	}
+	orgID := org.ID.String()
+	vault, err := c.CreateVault(ctx, orgID, client.CreateVaultRequest{Name: "prod"})
+	if err != nil {
+		t.Fatalf("CreateVault: %v", err)
+	}
+	team, err := c.CreateTeam(ctx, orgID, client.CreateTeamRequest{Name: "eng"})
+	if err != nil {
+		t.Fatalf("CreateTeam: %v", err)
+	}
+
+	member, err := c.AddTeamMember(ctx, orgID, team.ID.String(), client.AddTeamMemberRequest{UserID: userID, Role: "member"})
+	if err != nil {
+		t.Fatalf("AddTeamMember: %v", err)
+	}
+	if _, err := c.AddTeamMember(ctx, orgID, team.ID.String(), client.AddTeamMemberRequest{UserID: userID, Role: "maintainer"}); !client.IsConflict(err) {
+		t.Errorf("duplicate member: err = %v, want conflict", err)
+	}
+	if _, err := c.AddTeamMember(ctx, orgID, team.ID.String(), client.AddTeamMemberRequest{UserID: "1", Role: "member"}); !client.IsNotFound(err) {
+		t.Errorf("unknown user: err = %v, want not found", err)
+	}
+	if _, err := c.UpdateTeamMember(ctx, orgID, team.ID.String(), member.ID, client.UpdateTeamMemberRequest{Role: "maintainer", IfMatch: member.ETag}); err != nil {
+		t.Errorf("UpdateTeamMember: %v", err)
+	}
+
+	userGrant, err := c.CreateVaultUserGrant(ctx, orgID, vault.ID.String(), client.CreateVaultUserGrantRequest{UserID: userID, Role: "reader"})
+	if err != nil {
+		t.Fatalf("CreateVaultUserGrant: %v", err)
+	}
+	if userGrant.GrantedByUserID != srv.UserID {
+		t.Errorf("granted_by_user_id = %q, want %q", userGrant.GrantedByUserID, srv.UserID)
+	}
+	if _, err := c.CreateVaultUserGrant(ctx, orgID, vault.ID.String(), client.CreateVaultUserGrantRequest{UserID: userID, Role: "owner"}); !client.IsValidation(err) {
+		t.Errorf("invalid role: err = %v, want validation error", err)
+	}
+	teamGrant, err := c.CreateVaultTeamGrant(ctx, orgID, vault.ID.String(), client.CreateVaultTeamGrantRequest{TeamID: team.ID.String(), Role: "writer"})
+	if err != nil {
+		t.Fatalf("CreateVaultTeamGrant: %v", err)
+	}
+
+	grants, err := c.ListVaultTeamGrants(ctx, orgID, vault.ID.String())
+	if err != nil || len(grants) != 1 || grants[0].ID != teamGrant.ID {
+		t.Fatalf("ListVaultTeamGrants = %v, %v", grants, err)
+	}
+
+	// Deleting the team removes its grants.
+	if err := c.DeleteTeam(ctx, orgID, team.ID.String()); err != nil {
+		t.Fatalf("DeleteTeam: %v", err)
+	}
+	if _, err := c.GetVaultTeamGrant(ctx, orgID, vault.ID.String(), teamGrant.ID); !client.IsNotFound(err) {
+		t.Errorf("grant of deleted team: err = %v, want not found", err)
+	}
+}
+
+func TestServerClientCertificatesAuthenticate(t *testing.T) {
+	srv, c := newClient(t, Options{})
+	ctx := context.Background()
+
+	org, err := c.CreateOrganization(ctx, client.CreateOrganizationRequest{Name: "acme"})
+	if err != nil {
+		t.Fatalf("CreateOrganization: %v", err)
+	}
+	orgID := org.ID.String()
+	vault, err := c.CreateVault(ctx, orgID, client.CreateVaultRequest{Name: "prod"})
+	if err != nil {
+		t.Fatalf("CreateVault: %v", err)
+	}
+	apiClient, err := c.CreateClient(ctx, orgID, client.CreateClientRequest{Name: "backend", VaultID: vault.ID.String()})
+	if err != nil {
+		t.Fatalf("CreateClient: %v", err)
+	}
+	cert, err := c.CreateCertificate(ctx, orgID, apiClient.ID.String(), client.CreateCertificateRequest{Name: "key-1"})
+	if err != nil {
+		t.Fatalf("CreateCertificate: %v", err)
+	}
+	if cert.PrivateKeyPEM == "" {
+		t.Fatal("certificate was created without a private key")
+	}
+	if got, err := c.GetCertificate(ctx, orgID, apiClient.ID.String(), cert.ID); err != nil || got.PrivateKeyPEM != "" {
+		t.Errorf("GetCertificate = %+v, %v; want no private key", got, err)
+	}
+
+	service := client.New(client.Config{Endpoint: srv.URL, Authenticator: mustAuth(t, apiClient.ID.String(), cert.KID, cert.PrivateKeyPEM), MaxRetries: -1})
+	if _, err := service.GetVault(ctx, orgID, vault.ID.String()); err != nil {
+		t.Fatalf("GetVault as service account: %v", err)
+	}
+
+	if err := c.RevokeCertificate(ctx, orgID, apiClient.ID.String(), cert.ID); err != nil {
+		t.Fatalf("RevokeCertificate: %v", err)
+	}
+	revoked := client.New(client.Config{Endpoint: srv.URL, Authenticator: mustAuth(t, apiClient.ID.String(), cert.KID, cert.PrivateKeyPEM), MaxRetries: -1})
+	if _, err := revoked.GetVault(ctx, orgID, vault.ID.String()); err == nil {
+		t.Error("revoked certificate still authenticates")
+	}
+}
+
+func TestServerRejectsMissingCredentials(t *testing.T) {
+	srv := New(Options{})
+	defer srv.Close()
+
+	c := client.New(client.Config{Endpoint: srv.URL, MaxRetries: -1})
+	if _, err := c.ListOrganizations(context.Background()); !client.IsUnauthorized(err) {
+		t.Errorf("err = %v, want unauthorized", err)
+	}
+}
+
+func TestServerPaginates(t *testing.T) {
+	srv, _ := newClient(t, Options{})
+	c := client.New(client.Config{Endpoint: srv.URL, SessionToken: srv.SessionToken, PageSize: 2, CacheTTL: -1})
+	ctx := context.Background()
+
+	for i := range 5 {
+		if _, err := c.CreateOrganization(ctx, client.CreateOrganizationRequest{Name: "org-" + strconv.Itoa(i)}); err != nil {
+			t.Fatalf("CreateOrganization: %v", err)
+		}
+	}
+	orgs, err := c.ListOrganizations(ctx)
+	if err != nil {
+		t.Fatalf("ListOrganizations: %v", err)
+	}
+	if len(orgs) != 5 || orgs[0].Name != "org-0" || orgs[4].Name != "org-4" {
+		t.Errorf("ListOrganizations = %+v, want org-0 through org-4 in order", orgs)
+	}
+}
+
+func mustAuth(t *testing.T, clientID, kid, key string) client.Authenticator {
+	t.Helper()
+
+	auth, err := client.NewClientAssertionAuth(clientID, kid, key)
+	if err != nil {
+		t.Fatalf("NewClientAssertionAuth: %v", err)
+	}
+	return auth
+}
+
+// Certificates, team members and vault grants are listed as bare arrays by
+// the Control API, unlike the paginated organization, vault, client and team
+// lists.
+func TestServerListsBareArrays(t *testing.T) {
+	srv, c := newClient(t, Options{})
+	ctx := context.Background()
+	userID := srv.AddUser("dev@example.com")
+
+	org, err := c.CreateOrganization(ctx, client.CreateOrganizationRequest{Name: "acme"})
+	if err != nil {
+		t.Fatalf("CreateOrganization: %v", err)
+	}
+	orgID := org.ID.String()
+	vault, err := c.CreateVault(ctx, orgID, client.CreateVaultRequest{Name: "prod"})
+	if err != nil {
+		t.Fatalf("CreateVault: %v", err)
+	}
+	apiClient, err := c.CreateClient(ctx, orgID, client.CreateClientRequest{Name: "backend", VaultID: vault.ID.String()})
+	if err != nil {
+		t.Fatalf("CreateClient: %v", err)
+	}
+	team, err := c.CreateTeam(ctx, orgID, client.CreateTeamRequest{Name: "eng"})
+	if err != nil {
+		t.Fatalf("CreateTeam: %v", err)
+	}
+
+	for path, list := range map[string]string{
+		fmt.Sprintf("/v1/organizations/%s/clients/%s/certificates", orgID, apiClient.ID): "",
+		fmt.Sprintf("/v1/organizations/%s/teams/%s/members", orgID, team.ID):             "",
+		fmt.Sprintf("/v1/organizations/%s/vaults/%s/user-grants", orgID, vault.ID):       "",
+		fmt.Sprintf("/v1/organizations/%s/vaults/%s/team-grants", orgID, vault.ID):       "",
+		fmt.Sprintf("/v1/organizations/%s/vaults", orgID):                                "vaults",
+	} {
+		body := getRaw(t, srv, path)
+		if list == "" {
+			if !strings.HasPrefix(body, "[") {
+				t.Errorf("GET %s = %s, want a bare array", path, body)
+			}
+			continue
+		}
+		if !strings.Contains(body, `"`+list+`":`) {
+			t.Errorf("GET %s = %s, want a %s envelope", path, body, list)
+		}
+	}
+
+	if _, err := c.AddTeamMember(ctx, orgID, team.ID.String(), client.AddTeamMemberRequest{UserID: userID, Role: "member"}); err != nil {
+		t.Fatalf("AddTeamMember: %v", err)
+	}
+	members, err := c.ListTeamMembers(ctx, orgID, team.ID.String())
+	if err != nil || len(members) != 1 {
+		t.Errorf("ListTeamMembers = %+v, %v; want one member", members, err)
+	}
+}
+
+func getRaw(t *testing.T, srv *Server, path string) string {
+	t.Helper()
+
+	req, err := http.NewRequest(http.MethodGet, srv.URL+path, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	req.AddCookie(&http.Cookie{Name: "infera_session", Value: srv.SessionToken})
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer resp.Body.Close()
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if resp.StatusCode != http.StatusOK {
+		t.Fatalf("GET %s: status %d: %s", path, resp.StatusCode, body)
+	}
+	return strings.TrimSpace(string(body))
+}
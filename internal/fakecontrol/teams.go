@@ -0,0 +1,314 @@
+// Copyright 2025 InferaDB
+// SPDX-License-Identifier: Apache-2.0
+
+package fakecontrol
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+)
+
+// teamMemberRoles are the roles a team member can hold.
+var teamMemberRoles = map[string]bool{"maintainer": true, "member": true}
+
+type team struct {
+	ID             int64  `json:"id"`
+	OrganizationID int64  `json:"organization_id"`
+	Name           string `json:"name"`
+	Description    string `json:"description,omitempty"`
+	CreatedAt      string `json:"created_at"`
+	UpdatedAt      string `json:"updated_at"`
+
+	version int
+}
+
+func (t *team) key() string {
+	return strconv.FormatInt(t.ID, 10)
+}
+
+func (t *team) etag() string {
+	return etagOf(t.key(), t.version)
+}
+
+type teamMember struct {
+	ID        string `json:"id"`
+	TeamID    string `json:"team_id"`
+	UserID    string `json:"user_id"`
+	Role      string `json:"role"`
+	CreatedAt string `json:"created_at"`
+
+	version int
+}
+
+func (m *teamMember) etag() string {
+	return etagOf(m.ID, m.version)
+}
+
+// team returns the team of org named by the {team} path segment, answering
+// 404 when it does not exist.
+func (s *Server) team(w http.ResponseWriter, r *http.Request, org *organization) (*team, bool) {
+	id := r.PathValue("team")
+	t, ok := s.teams.get(id)
+	if !ok || t.OrganizationID != org.ID {
+		writeNotFound(w, "team", id)
+		return nil, false
+	}
+	return t, true
+}
+
+// teamMember returns the member of t named by the {member} path segment,
+// answering 404 when it does not exist.
+func (s *Server) teamMember(w http.ResponseWriter, r *http.Request, t *team) (*teamMember, bool) {
+	id := r.PathValue("member")
+	m, ok := s.members.get(id)
+	if !ok || m.TeamID != t.key() {
+		writeNotFound(w, "team member", id)
+		return nil, false
+	}
+	return m, true
+}
+
+// teamNameTaken answers 409 when org already has another team named name.
+func (s *Server) teamNameTaken(w http.ResponseWriter, org *organization, name string, except int64) bool {
+	taken := s.teams.list(func(t *team) bool {
+		return t.OrganizationID == org.ID && t.Name == name && t.ID != except
+	})
+	if len(taken) == 0 {
+		return false
+	}
+	writeError(w, http.StatusConflict, "CONFLICT", fmt.Sprintf("a team named %q already exists", name))
+	return true
+}
+
+func (s *Server) createTeam(w http.ResponseWriter, r *http.Request) {
+	org, ok := s.organization(w, r)
+	if !ok {
+		return
+	}
+
+	var req struct {
+		Name        string `json:"name"`
+		Description string `json:"description"`
+	}
+	if !decode(w, r, &req) {
+		return
+	}
+	if req.Name == "" {
+		writeError(w, http.StatusBadRequest, "VALIDATION_ERROR", "name is required")
+		return
+	}
+	if s.teamNameTaken(w, org, req.Name, 0) {
+		return
+	}
+
+	t := &team{
+		ID:             s.nextID(),
+		OrganizationID: org.ID,
+		Name:           req.Name,
+		Description:    req.Description,
+		CreatedAt:      now(),
+		version:        1,
+	}
+	t.UpdatedAt = t.CreatedAt
+	s.teams.put(t.key(), t)
+
+	writeObject(w, http.StatusCreated, t.etag(), map[string]interface{}{"team": t})
+}
+
+func (s *Server) listTeams(w http.ResponseWriter, r *http.Request) {
+	org, ok := s.organization(w, r)
+	if !ok {
+		return
+	}
+	writePage(w, r, "teams", s.teams.list(func(t *team) bool { return t.OrganizationID == org.ID }))
+}
+
+func (s *Server) getTeam(w http.ResponseWriter, r *http.Request) {
+	org, ok := s.organization(w, r)
+	if !ok {
+		return
+	}
+	t, ok := s.team(w, r, org)
+	if !ok {
+		return
+	}
+
+	// Unlike the other team routes, GET returns the team unwrapped.
+	writeObject(w, http.StatusOK, t.etag(), t)
+}
+
+func (s *Server) updateTeam(w http.ResponseWriter, r *http.Request) {
+	org, ok := s.organization(w, r)
+	if !ok {
+		return
+	}
+	t, ok := s.team(w, r, org)
+	if !ok || !checkIfMatch(w, r, t.etag()) {
+		return
+	}
+
+	var req struct {
+		Name        string `json:"name"`
+		Description string `json:"description"`
+	}
+	if !decode(w, r, &req) {
+		return
+	}
+	if req.Name != "" {
+		if s.teamNameTaken(w, org, req.Name, t.ID) {
+			return
+		}
+		t.Name = req.Name
+	}
+	if req.Description != "" {
+		t.Description = req.Description
+	}
+	t.UpdatedAt = now()
+	t.version++
+
+	writeObject(w, http.StatusOK, t.etag(), map[string]interface{}{"team": t})
+}
+
+func (s *Server) deleteTeam(w http.ResponseWriter, r *http.Request) {
+	org, ok := s.organization(w, r)
+	if !ok {
+		return
+	}
+	t, ok := s.team(w, r, org)
+	if !ok {
+		return
+	}
+	s.removeTeam(t)
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// removeTeam deletes a team together with its members and vault grants.
+func (s *Server) removeTeam(t *team) {
+	teamID := t.key()
+	for _, m := range s.members.list(func(m *teamMember) bool { return m.TeamID == teamID }) {
+		s.members.delete(m.ID)
+	}
+	for _, g := range s.teamGrants.list(func(g *vaultTeamGrant) bool { return g.TeamID == teamID }) {
+		s.teamGrants.delete(g.ID)
+	}
+	s.teams.delete(teamID)
+}
+
+func (s *Server) addTeamMember(w http.ResponseWriter, r *http.Request) {
+	org, ok := s.organization(w, r)
+	if !ok {
+		return
+	}
+	t, ok := s.team(w, r, org)
+	if !ok {
+		return
+	}
+
+	var req struct {
+		UserID string `json:"user_id"`
+		Role   string `json:"role"`
+	}
+	if !decode(w, r, &req) {
+		return
+	}
+	if !teamMemberRoles[req.Role] {
+		writeError(w, http.StatusBadRequest, "VALIDATION_ERROR", fmt.Sprintf("unknown team member role %q", req.Role))
+		return
+	}
+	if _, ok := s.users[req.UserID]; !ok {
+		writeNotFound(w, "user", req.UserID)
+		return
+	}
+	if len(s.members.list(func(m *teamMember) bool { return m.TeamID == t.key() && m.UserID == req.UserID })) > 0 {
+		writeError(w, http.StatusConflict, "CONFLICT", fmt.Sprintf("user %s is already a member of the team", req.UserID))
+		return
+	}
+
+	m := &teamMember{
+		ID:        strconv.FormatInt(s.nextID(), 10),
+		TeamID:    t.key(),
+		UserID:    req.UserID,
+		Role:      req.Role,
+		CreatedAt: now(),
+		version:   1,
+	}
+	s.members.put(m.ID, m)
+
+	writeObject(w, http.StatusCreated, m.etag(), m)
+}
+
+func (s *Server) listTeamMembers(w http.ResponseWriter, r *http.Request) {
+	org, ok := s.organization(w, r)
+	if !ok {
+		return
+	}
+	t, ok := s.team(w, r, org)
+	if !ok {
+		return
+	}
+	writeList(w, s.members.list(func(m *teamMember) bool { return m.TeamID == t.key() }))
+}
+
+func (s *Server) getTeamMember(w http.ResponseWriter, r *http.Request) {
+	org, ok := s.organization(w, r)
+	if !ok {
+		return
+	}
+	t, ok := s.team(w, r, org)
+	if !ok {
+		return
+	}
+	m, ok := s.teamMember(w, r, t)
+	if !ok {
+		return
+	}
+	writeObject(w, http.StatusOK, m.etag(), m)
+}
+
+func (s *Server) updateTeamMember(w http.ResponseWriter, r *http.Request) {
+	org, ok := s.organization(w, r)
+	if !ok {
+		return
+	}
+	t, ok := s.team(w, r, org)
+	if !ok {
+		return
+	}
+	m, ok := s.teamMember(w, r, t)
+	if !ok || !checkIfMatch(w, r, m.etag()) {
+		return
+	}
+
+	var req struct {
+		Role string `json:"role"`
+	}
+	if !decode(w, r, &req) {
+		return
+	}
+	if !teamMemberRoles[req.Role] {
+		writeError(w, http.StatusBadRequest, "VALIDATION_ERROR", fmt.Sprintf("unknown team member role %q", req.Role))
+		return
+	}
+	m.Role = req.Role
+	m.version++
+
+	writeObject(w, http.StatusOK, m.etag(), m)
+}
+
+func (s *Server) removeTeamMember(w http.ResponseWriter, r *http.Request) {
+	org, ok := s.organization(w, r)
+	if !ok {
+		return
+	}
+	t, ok := s.team(w, r, org)
+	if !ok {
+		return
+	}
+	m, ok := s.teamMember(w, r, t)
+	if !ok {
+		return
+	}
+	s.members.delete(m.ID)
+	w.WriteHeader(http.StatusNoContent)
+}
@@ -0,0 +1,181 @@
+// Copyright 2025 InferaDB
+// SPDX-License-Identifier: Apache-2.0
+
+package fakecontrol
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+)
+
+type vault struct {
+	ID             int64  `json:"id"`
+	OrganizationID int64  `json:"organization_id"`
+	Name           string `json:"name"`
+	Description    string `json:"description,omitempty"`
+	SyncStatus     string `json:"sync_status"`
+	CreatedAt      string `json:"created_at"`
+	UpdatedAt      string `json:"updated_at"`
+
+	version int
+}
+
+func (v *vault) key() string {
+	return strconv.FormatInt(v.ID, 10)
+}
+
+func (v *vault) etag() string {
+	return etagOf(v.key(), v.version)
+}
+
+// vault returns the vault of org named by the {vault} path segment,
+// answering 404 when it does not exist.
+func (s *Server) vault(w http.ResponseWriter, r *http.Request, org *organization) (*vault, bool) {
+	id := r.PathValue("vault")
+	v, ok := s.vaults.get(id)
+	if !ok || v.OrganizationID != org.ID {
+		writeNotFound(w, "vault", id)
+		return nil, false
+	}
+	return v, true
+}
+
+// vaultNameTaken answers 409 when org already has another vault named name.
+func (s *Server) vaultNameTaken(w http.ResponseWriter, org *organization, name string, except int64) bool {
+	taken := s.vaults.list(func(v *vault) bool {
+		return v.OrganizationID == org.ID && v.Name == name && v.ID != except
+	})
+	if len(taken) == 0 {
+		return false
+	}
+	writeError(w, http.StatusConflict, "CONFLICT", fmt.Sprintf("a vault named %q already exists", name))
+	return true
+}
+
+func (s *Server) createVault(w http.ResponseWriter, r *http.Request) {
+	org, ok := s.organization(w, r)
+	if !ok {
+		return
+	}
+
+	var req struct {
+		Name        string `json:"name"`
+		Description string `json:"description"`
+	}
+	if !decode(w, r, &req) {
+		return
+	}
+	if req.Name == "" {
+		writeError(w, http.StatusBadRequest, "VALIDATION_ERROR", "name is required")
+		return
+	}
+	if s.vaultNameTaken(w, org, req.Name, 0) {
+		return
+	}
+
+	v := &vault{
+		ID:             s.nextID(),
+		OrganizationID: org.ID,
+		Name:           req.Name,
+		Description:    req.Description,
+		SyncStatus:     "pending",
+		CreatedAt:      now(),
+		version:        1,
+	}
+	v.UpdatedAt = v.CreatedAt
+	s.vaults.put(v.key(), v)
+	s.pendingVaults[v.key()] = s.opts.PendingVaultReads
+
+	writeObject(w, http.StatusCreated, v.etag(), map[string]interface{}{"vault": v})
+}
+
+func (s *Server) listVaults(w http.ResponseWriter, r *http.Request) {
+	org, ok := s.organization(w, r)
+	if !ok {
+		return
+	}
+	writePage(w, r, "vaults", s.vaults.list(func(v *vault) bool { return v.OrganizationID == org.ID }))
+}
+
+func (s *Server) getVault(w http.ResponseWriter, r *http.Request) {
+	org, ok := s.organization(w, r)
+	if !ok {
+		return
+	}
+	v, ok := s.vault(w, r, org)
+	if !ok {
+		return
+	}
+
+	// The engine picks new vaults up after PendingVaultReads reads.
+	if v.SyncStatus == "pending" {
+		if s.pendingVaults[v.key()] > 0 {
+			s.pendingVaults[v.key()]--
+		} else {
+			v.SyncStatus = "synced"
+			delete(s.pendingVaults, v.key())
+		}
+	}
+
+	// Unlike the other vault routes, GET returns the vault unwrapped.
+	writeObject(w, http.StatusOK, v.etag(), v)
+}
+
+func (s *Server) updateVault(w http.ResponseWriter, r *http.Request) {
+	org, ok := s.organization(w, r)
+	if !ok {
+		return
+	}
+	v, ok := s.vault(w, r, org)
+	if !ok || !checkIfMatch(w, r, v.etag()) {
+		return
+	}
+
+	var req struct {
+		Name        string `json:"name"`
+		Description string `json:"description"`
+	}
+	if !decode(w, r, &req) {
+		return
+	}
+	if req.Name != "" {
+		if s.vaultNameTaken(w, org, req.Name, v.ID) {
+			return
+		}
+		v.Name = req.Name
+	}
+	if req.Description != "" {
+		v.Description = req.Description
+	}
+	v.UpdatedAt = now()
+	v.version++
+
+	writeObject(w, http.StatusOK, v.etag(), map[string]interface{}{"vault": v})
+}
+
+func (s *Server) deleteVault(w http.ResponseWriter, r *http.Request) {
+	org, ok := s.organization(w, r)
+	if !ok {
+		return
+	}
+	v, ok := s.vault(w, r, org)
+	if !ok {
+		return
+	}
+	s.removeVault(v)
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// removeVault deletes a vault together with its grants.
+func (s *Server) removeVault(v *vault) {
+	vaultID := v.key()
+	for _, g := range s.userGrants.list(func(g *vaultUserGrant) bool { return g.VaultID == vaultID }) {
+		s.userGrants.delete(g.ID)
+	}
+	for _, g := range s.teamGrants.list(func(g *vaultTeamGrant) bool { return g.VaultID == vaultID }) {
+		s.teamGrants.delete(g.ID)
+	}
+	delete(s.pendingVaults, vaultID)
+	s.vaults.delete(vaultID)
+}
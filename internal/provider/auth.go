@@ -0,0 +1,330 @@
+// Copyright 2025 InferaDB
+// SPDX-License-Identifier: Apache-2.0
+
+package provider
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/inferadb/terraform-provider-inferadb/internal/client"
+)
+
+// Authentication strategies selectable with auth.type.
+const (
+	authTypeSession = "session"
+	authTypeBearer  = "bearer"
+	authTypeHeader  = "header"
+	// authTypeClientCertificate signs JWT assertions with an inferadb_client
+	// certificate and exchanges them for short-lived access tokens.
+	authTypeClientCertificate = "client_certificate"
+	// authTypeOIDC exchanges a workload OIDC ID token, such as one issued to
+	// a CI job, for short-lived access tokens.
+	authTypeOIDC = "oidc"
+)
+
+// defaultOIDCTokenEnv is the environment variable read for the ID token when
+// neither oidc_token_file nor oidc_token_env is set.
+const defaultOIDCTokenEnv = "INFERADB_OIDC_TOKEN"
+
+// AuthModel describes the provider auth block.
+type AuthModel struct {
+	Type       types.String `tfsdk:"type"`
+	Token      types.String `tfsdk:"token"`
+	HeaderName types.String `tfsdk:"header_name"`
+
+	ClientID       types.String `tfsdk:"client_id"`
+	CertificateKID types.String `tfsdk:"certificate_kid"`
+	PrivateKeyPEM  types.String `tfsdk:"private_key_pem"`
+	PrivateKeyFile types.String `tfsdk:"private_key_file"`
+
+	OIDCTokenFile types.String `tfsdk:"oidc_token_file"`
+	OIDCTokenEnv  types.String `tfsdk:"oidc_token_env"`
+}
+
+// authBlock returns the schema of the provider auth block.
+func authBlock() schema.SingleNestedBlock {
+	return schema.SingleNestedBlock{
+		MarkdownDescription: "Selects how the provider authenticates to the Control API. When omitted, the session token is sent as an `infera_session` cookie.",
+		Attributes: map[string]schema.Attribute{
+			"type": schema.StringAttribute{
+				MarkdownDescription: "Authentication strategy. One of `session` (`infera_session` cookie), `bearer` (`Authorization: Bearer` header), `header` (custom header named by `header_name`), `client_certificate` (service account, see `client_id`) or `oidc` (workload identity federation, see `oidc_token_file`). Defaults to `client_certificate` when `client_id` is set and to `oidc` when `oidc_token_file` or `oidc_token_env` is set. When no session token is configured, it also defaults to `client_certificate` when `INFERADB_CLIENT_ID` is set and to `oidc` when `INFERADB_OIDC_TOKEN_FILE` or `INFERADB_OIDC_TOKEN` is set. Otherwise it defaults to `session`.",
+				Optional:            true,
+			},
+			"token": schema.StringAttribute{
+				MarkdownDescription: "Credential sent with every request. Defaults to the provider `session_token`.",
+				Optional:            true,
+				Sensitive:           true,
+			},
+			"header_name": schema.StringAttribute{
+				MarkdownDescription: "Name of the header carrying `token` when `type` is `header`, e.g. `X-API-Key`.",
+				Optional:            true,
+			},
+			"client_id": schema.StringAttribute{
+				MarkdownDescription: "ID of the `inferadb_client` to authenticate as when `type` is `client_certificate`. Can also be set via `INFERADB_CLIENT_ID` environment variable.",
+				Optional:            true,
+			},
+			"certificate_kid": schema.StringAttribute{
+				MarkdownDescription: "Key ID (`kid`) of the client certificate whose private key signs the assertions. Can also be set via `INFERADB_CERTIFICATE_KID` environment variable.",
+				Optional:            true,
+			},
+			"private_key_pem": schema.StringAttribute{
+				MarkdownDescription: "PEM encoded Ed25519 private key of the client certificate. Can also be set via `INFERADB_PRIVATE_KEY_PEM` environment variable. Conflicts with `private_key_file`.",
+				Optional:            true,
+				Sensitive:           true,
+			},
+			"private_key_file": schema.StringAttribute{
+				MarkdownDescription: "Path to a file holding the PEM encoded private key of the client certificate. Can also be set via `INFERADB_PRIVATE_KEY_FILE` environment variable.",
+				Optional:            true,
+			},
+			"oidc_token_file": schema.StringAttribute{
+				MarkdownDescription: "Path to a file holding the OIDC ID token exchanged when `type` is `oidc`. The file is read again on every exchange, so rotated tokens are picked up. Can also be set via `INFERADB_OIDC_TOKEN_FILE` environment variable.",
+				Optional:            true,
+			},
+			"oidc_token_env": schema.StringAttribute{
+				MarkdownDescription: "Name of the environment variable holding the OIDC ID token when `type` is `oidc`, e.g. a GitLab CI `id_tokens` variable. Defaults to `INFERADB_OIDC_TOKEN`. Conflicts with `oidc_token_file`.",
+				Optional:            true,
+			},
+		},
+	}
+}
+
+// newAuthenticator builds the client authenticator selected by the auth
+// block. sessionToken is the token resolved from session_token and the
+// environment; it is used when the block does not set its own token.
+func newAuthenticator(auth *AuthModel, sessionToken string) (client.Authenticator, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	if auth == nil {
+		auth = &AuthModel{}
+	}
+
+	clientID := stringWithEnv(auth.ClientID, "INFERADB_CLIENT_ID")
+
+	oidcTokenFile := stringWithEnv(auth.OIDCTokenFile, "INFERADB_OIDC_TOKEN_FILE")
+
+	// A client ID or ID token source in the auth block selects its strategy.
+	// Ones from the environment only do so when no session token is
+	// configured, so they cannot override an explicit session_token.
+	hasToken := sessionToken != "" || !auth.Token.IsNull()
+
+	authType := authTypeSession
+	switch {
+	case !auth.ClientID.IsNull():
+		authType = authTypeClientCertificate
+	case !auth.OIDCTokenFile.IsNull() || !auth.OIDCTokenEnv.IsNull():
+		authType = authTypeOIDC
+	case hasToken:
+		// Keep session auth over credentials from the environment.
+	case clientID != "":
+		authType = authTypeClientCertificate
+	case oidcTokenFile != "" || os.Getenv(defaultOIDCTokenEnv) != "":
+		authType = authTypeOIDC
+	}
+	if !auth.Type.IsNull() {
+		authType = auth.Type.ValueString()
+	}
+
+	if authType == authTypeClientCertificate {
+		return newClientCertificateAuth(auth, clientID)
+	}
+	if authType == authTypeOIDC {
+		return newOIDCAuth(auth, oidcTokenFile)
+	}
+
+	token := sessionToken
+	if !auth.Token.IsNull() {
+		token = auth.Token.ValueString()
+	}
+
+	if token == "" {
+		diags.AddError(
+			"Missing Session Token",
+			"The provider requires a session token for authentication. "+
+				"Set the session_token in the provider configuration, "+
+				"set the INFERADB_SESSION_TOKEN environment variable, "+
+				"set token in the auth block, "+
+				"or configure a client certificate or OIDC ID token in the auth block.",
+		)
+		return nil, diags
+	}
+
+	switch authType {
+	case authTypeSession:
+		return client.SessionCookieAuth{Token: token}, diags
+	case authTypeBearer:
+		return client.BearerTokenAuth{Token: token}, diags
+	case authTypeHeader:
+		if auth.HeaderName.ValueString() == "" {
+			diags.AddAttributeError(
+				path.Root("auth").AtName("header_name"),
+				"Missing Header Name",
+				"header_name is required when auth type is \"header\".",
+			)
+			return nil, diags
+		}
+		return client.HeaderAuth{Name: auth.HeaderName.ValueString(), Value: token}, diags
+	}
+
+	diags.AddAttributeError(
+		path.Root("auth").AtName("type"),
+		"Invalid Authentication Type",
+		fmt.Sprintf("Expected one of %q, %q, %q, %q or %q, got: %q.",
+			authTypeSession, authTypeBearer, authTypeHeader, authTypeClientCertificate, authTypeOIDC, authType),
+	)
+	return nil, diags
+}
+
+// newClientCertificateAuth builds a service account authenticator from the
+// client certificate settings of the auth block and their environment
+// variables.
+func newClientCertificateAuth(auth *AuthModel, clientID string) (client.Authenticator, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	keyID := stringWithEnv(auth.CertificateKID, "INFERADB_CERTIFICATE_KID")
+
+	// The key in the configuration takes precedence over the environment;
+	// within each source, inline PEM takes precedence over a file.
+	var keyPEM, keyFile string
+	switch {
+	case !auth.PrivateKeyPEM.IsNull():
+		keyPEM = auth.PrivateKeyPEM.ValueString()
+	case !auth.PrivateKeyFile.IsNull():
+		keyFile = auth.PrivateKeyFile.ValueString()
+	default:
+		keyPEM = os.Getenv("INFERADB_PRIVATE_KEY_PEM")
+		if keyPEM == "" {
+			keyFile = os.Getenv("INFERADB_PRIVATE_KEY_FILE")
+		}
+	}
+
+	if clientID == "" {
+		diags.AddAttributeError(
+			path.Root("auth").AtName("client_id"),
+			"Missing Client ID",
+			"client_id is required when auth type is \"client_certificate\". "+
+				"Set it in the auth block or via the INFERADB_CLIENT_ID environment variable.",
+		)
+	}
+	if keyID == "" {
+		diags.AddAttributeError(
+			path.Root("auth").AtName("certificate_kid"),
+			"Missing Certificate Key ID",
+			"certificate_kid is required when auth type is \"client_certificate\". "+
+				"Set it in the auth block or via the INFERADB_CERTIFICATE_KID environment variable.",
+		)
+	}
+	if !auth.PrivateKeyPEM.IsNull() && !auth.PrivateKeyFile.IsNull() {
+		diags.AddAttributeError(
+			path.Root("auth").AtName("private_key_file"),
+			"Conflicting Private Key Configuration",
+			"Only one of private_key_pem and private_key_file can be set.",
+		)
+	}
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	if keyPEM == "" && keyFile == "" {
+		diags.AddAttributeError(
+			path.Root("auth").AtName("private_key_pem"),
+			"Missing Private Key",
+			"One of private_key_pem or private_key_file is required when auth type is \"client_certificate\". "+
+				"They can also be set via the INFERADB_PRIVATE_KEY_PEM and INFERADB_PRIVATE_KEY_FILE environment variables.",
+		)
+		return nil, diags
+	}
+	if keyFile != "" {
+		data, err := os.ReadFile(keyFile)
+		if err != nil {
+			diags.AddAttributeError(
+				path.Root("auth").AtName("private_key_file"),
+				"Unable to Read Private Key",
+				fmt.Sprintf("Could not read private key file %s: %s", keyFile, err),
+			)
+			return nil, diags
+		}
+		keyPEM = string(data)
+	}
+
+	authenticator, err := client.NewClientAssertionAuth(clientID, keyID, keyPEM)
+	if err != nil {
+		diags.AddAttributeError(
+			path.Root("auth").AtName("private_key_pem"),
+			"Invalid Client Certificate",
+			fmt.Sprintf("Could not load the client certificate private key: %s", err),
+		)
+		return nil, diags
+	}
+	return authenticator, diags
+}
+
+// newOIDCAuth builds a workload identity authenticator reading the ID token
+// from tokenFile or, when no file is configured, from an environment variable.
+func newOIDCAuth(auth *AuthModel, tokenFile string) (client.Authenticator, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	if !auth.OIDCTokenFile.IsNull() && !auth.OIDCTokenEnv.IsNull() {
+		diags.AddAttributeError(
+			path.Root("auth").AtName("oidc_token_env"),
+			"Conflicting OIDC Token Configuration",
+			"Only one of oidc_token_file and oidc_token_env can be set.",
+		)
+		return nil, diags
+	}
+
+	// A variable named in the configuration takes precedence over
+	// INFERADB_OIDC_TOKEN_FILE.
+	if !auth.OIDCTokenEnv.IsNull() {
+		tokenFile = ""
+	}
+	if tokenFile != "" {
+		return client.NewOIDCAuth(client.IDTokenFromFile(tokenFile)), diags
+	}
+
+	tokenEnv := defaultOIDCTokenEnv
+	if !auth.OIDCTokenEnv.IsNull() {
+		tokenEnv = auth.OIDCTokenEnv.ValueString()
+	}
+	if os.Getenv(tokenEnv) == "" {
+		diags.AddAttributeError(
+			path.Root("auth").AtName("oidc_token_env"),
+			"Missing OIDC ID Token",
+			fmt.Sprintf("The environment variable %s holds no ID token. "+
+				"Set oidc_token_file or INFERADB_OIDC_TOKEN_FILE to read the token from a file, "+
+				"or oidc_token_env to name the variable provided by your CI system.", tokenEnv),
+		)
+		return nil, diags
+	}
+	return client.NewOIDCAuth(client.IDTokenFromEnv(tokenEnv)), diags
+}
+
+// reauthHint tells the user how to recover when the API rejects the
+// credentials of authenticator. The client attaches it to the first failing
+// request only, so an expired session yields one actionable diagnostic.
+func reauthHint(authenticator client.Authenticator) string {
+	switch authenticator.(type) {
+	case client.SessionCookieAuth:
+		return "The session token has expired or was revoked. Run `inferadb login`, " +
+			"update session_token, INFERADB_SESSION_TOKEN or your credentials profile if needed, and run Terraform again"
+	case *client.ClientAssertionAuth:
+		return "Check that the client certificate configured in the auth block still exists and has not been revoked, " +
+			"and that the client is enabled"
+	case *client.OIDCAuth:
+		return "Check that the OIDC ID token is current and that InferaDB trusts its issuer and subject"
+	}
+	return "Check that the token configured in the auth block is valid and has not expired"
+}
+
+// stringWithEnv returns the configured value, or the named environment
+// variable when the attribute is not set.
+func stringWithEnv(value types.String, env string) string {
+	if !value.IsNull() {
+		return value.ValueString()
+	}
+	return os.Getenv(env)
+}
@@ -0,0 +1,116 @@
+// Copyright 2025 InferaDB
+// SPDX-License-Identifier: Apache-2.0
+
+package provider
+
+import (
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/x509"
+	"encoding/pem"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/inferadb/terraform-provider-inferadb/internal/client"
+)
+
+func TestNewAuthenticatorSelection(t *testing.T) {
+	_, priv, err := ed25519.GenerateKey(rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	der, err := x509.MarshalPKCS8PrivateKey(priv)
+	if err != nil {
+		t.Fatal(err)
+	}
+	keyPEM := string(pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: der}))
+
+	tests := []struct {
+		name         string
+		env          map[string]string
+		auth         *AuthModel
+		sessionToken string
+		want         string
+	}{
+		{
+			name:         "session token",
+			sessionToken: "token",
+			want:         authTypeSession,
+		},
+		{
+			name: "client ID from the environment",
+			env:  map[string]string{"INFERADB_CLIENT_ID": "1"},
+			want: authTypeClientCertificate,
+		},
+		{
+			name:         "client ID from the environment with a session token",
+			env:          map[string]string{"INFERADB_CLIENT_ID": "1"},
+			sessionToken: "token",
+			want:         authTypeSession,
+		},
+		{
+			name: "client ID from the environment with an auth token",
+			env:  map[string]string{"INFERADB_CLIENT_ID": "1"},
+			auth: &AuthModel{Token: types.StringValue("token")},
+			want: authTypeSession,
+		},
+		{
+			name: "ID token from the environment",
+			env:  map[string]string{defaultOIDCTokenEnv: "id-token"},
+			want: authTypeOIDC,
+		},
+		{
+			name:         "ID token from the environment with a session token",
+			env:          map[string]string{defaultOIDCTokenEnv: "id-token"},
+			sessionToken: "token",
+			want:         authTypeSession,
+		},
+		{
+			name:         "ID token file from the environment with a session token",
+			env:          map[string]string{"INFERADB_OIDC_TOKEN_FILE": "/var/run/id-token"},
+			sessionToken: "token",
+			want:         authTypeSession,
+		},
+		{
+			name:         "ID token variable in the auth block with a session token",
+			env:          map[string]string{defaultOIDCTokenEnv: "id-token"},
+			auth:         &AuthModel{OIDCTokenEnv: types.StringValue(defaultOIDCTokenEnv)},
+			sessionToken: "token",
+			want:         authTypeOIDC,
+		},
+		{
+			name:         "client ID in the auth block with a session token",
+			auth:         &AuthModel{ClientID: types.StringValue("1")},
+			sessionToken: "token",
+			want:         authTypeClientCertificate,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			for _, name := range []string{"INFERADB_CLIENT_ID", "INFERADB_OIDC_TOKEN_FILE", defaultOIDCTokenEnv} {
+				t.Setenv(name, tt.env[name])
+			}
+			t.Setenv("INFERADB_CERTIFICATE_KID", "kid")
+			t.Setenv("INFERADB_PRIVATE_KEY_PEM", keyPEM)
+
+			authenticator, diags := newAuthenticator(tt.auth, tt.sessionToken)
+			if diags.HasError() {
+				t.Fatalf("unexpected diagnostics: %v", diags)
+			}
+
+			var got string
+			switch authenticator.(type) {
+			case client.SessionCookieAuth:
+				got = authTypeSession
+			case *client.ClientAssertionAuth:
+				got = authTypeClientCertificate
+			case *client.OIDCAuth:
+				got = authTypeOIDC
+			}
+			if got != tt.want {
+				t.Errorf("newAuthenticator selected %q (%T), want %q", got, authenticator, tt.want)
+			}
+		})
+	}
+}
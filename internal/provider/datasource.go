@@ -0,0 +1,44 @@
+// Copyright 2025 InferaDB
+// SPDX-License-Identifier: Apache-2.0
+
+package provider
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/inferadb/terraform-provider-inferadb/internal/client"
+)
+
+// dataSourceReadError returns the diagnostic for a failed read of the object
+// of the given kind, e.g. "vault", looked up by a data source. Missing and
+// inaccessible objects are reported on the id attribute.
+func dataSourceReadError(kind, id string, err error) diag.Diagnostics {
+	var diags diag.Diagnostics
+	title := strings.ToUpper(kind[:1]) + kind[1:]
+
+	switch {
+	case client.IsNotFound(err):
+		diags.AddAttributeError(
+			path.Root("id"),
+			fmt.Sprintf("%s not found", title),
+			fmt.Sprintf("No %s with ID %s exists.", kind, id),
+		)
+	case client.IsForbidden(err):
+		diags.AddAttributeError(
+			path.Root("id"),
+			fmt.Sprintf("Access to %s denied", kind),
+			fmt.Sprintf("The authenticated user is not allowed to read %s %s.", kind, id),
+		)
+	default:
+		addClientError(
+			&diags,
+			fmt.Sprintf("Error reading %s", kind),
+			fmt.Sprintf("Could not read %s %s: %s", kind, id, err.Error()),
+			err,
+		)
+	}
+	return diags
+}
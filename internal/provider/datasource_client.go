@@ -26,12 +26,12 @@ type ClientDataSource struct {
 
 // ClientDataSourceModel describes the data source data model.
 type ClientDataSourceModel struct {
-	ID             types.String `tfsdk:"id"`
-	OrganizationID types.String `tfsdk:"organization_id"`
-	Name           types.String `tfsdk:"name"`
-	VaultID        types.String `tfsdk:"vault_id"`
-	IsActive       types.Bool   `tfsdk:"is_active"`
-	CreatedAt      types.String `tfsdk:"created_at"`
+	ID             SnowflakeIDValue `tfsdk:"id"`
+	OrganizationID SnowflakeIDValue `tfsdk:"organization_id"`
+	Name           types.String     `tfsdk:"name"`
+	VaultID        SnowflakeIDValue `tfsdk:"vault_id"`
+	IsActive       types.Bool       `tfsdk:"is_active"`
+	CreatedAt      TimestampValue   `tfsdk:"created_at"`
 }
 
 // NewClientDataSource is a helper function to simplify the provider implementation.
@@ -65,10 +65,12 @@ output "client_name" {
 ` + "```",
 		Attributes: map[string]schema.Attribute{
 			"id": schema.StringAttribute{
+				CustomType:          SnowflakeIDType{},
 				MarkdownDescription: "Snowflake ID of the client.",
 				Required:            true,
 			},
 			"organization_id": schema.StringAttribute{
+				CustomType:          SnowflakeIDType{},
 				MarkdownDescription: "Snowflake ID of the organization that owns this client.",
 				Required:            true,
 			},
@@ -77,6 +79,7 @@ output "client_name" {
 				Computed:            true,
 			},
 			"vault_id": schema.StringAttribute{
+				CustomType:          SnowflakeIDType{},
 				MarkdownDescription: "Snowflake ID of the vault this client is associated with.",
 				Computed:            true,
 			},
@@ -85,6 +88,7 @@ output "client_name" {
 				Computed:            true,
 			},
 			"created_at": schema.StringAttribute{
+				CustomType:          TimestampType{},
 				MarkdownDescription: "ISO 8601 timestamp when the client was created.",
 				Computed:            true,
 			},
@@ -123,20 +127,17 @@ func (d *ClientDataSource) Read(ctx context.Context, req datasource.ReadRequest,
 	// Get client from API
 	clientResp, err := d.client.GetClient(ctx, data.OrganizationID.ValueString(), data.ID.ValueString())
 	if err != nil {
-		resp.Diagnostics.AddError(
-			"Error reading client",
-			fmt.Sprintf("Could not read client %s: %s", data.ID.ValueString(), err.Error()),
-		)
+		resp.Diagnostics.Append(dataSourceReadError("client", data.ID.ValueString(), err)...)
 		return
 	}
 
 	// Map response to data model
-	data.ID = types.StringValue(clientResp.ID.String())
-	data.OrganizationID = types.StringValue(clientResp.OrganizationID.String())
+	data.ID = NewSnowflakeIDValue(clientResp.ID.String())
+	data.OrganizationID = NewSnowflakeIDValue(clientResp.OrganizationID.String())
 	data.Name = types.StringValue(clientResp.Name)
-	data.VaultID = types.StringValue(clientResp.VaultID.String())
+	data.VaultID = NewSnowflakeIDValue(clientResp.VaultID.String())
 	data.IsActive = types.BoolValue(clientResp.IsActive)
-	data.CreatedAt = types.StringValue(clientResp.CreatedAt)
+	data.CreatedAt = NewTimestampValue(clientResp.CreatedAt)
 
 	// Save data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
@@ -0,0 +1,68 @@
+// Copyright 2025 InferaDB
+// SPDX-License-Identifier: Apache-2.0
+
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccClientDataSource(t *testing.T) {
+	testCase := testClientDataSourceCase()
+	testCase.PreCheck = func() { testAccPreCheck(t) }
+	resource.Test(t, testCase)
+}
+
+func TestClientDataSource(t *testing.T) {
+	testFakeControl(t)
+	resource.UnitTest(t, testClientDataSourceCase())
+}
+
+func testClientDataSourceCase() resource.TestCase {
+	rName := acctest.RandomWithPrefix("tf-test")
+
+	return resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccClientDataSourceConfig(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair("data.inferadb_client.test", "name", "inferadb_client.test", "name"),
+					resource.TestCheckResourceAttrPair("data.inferadb_client.test", "organization_id", "inferadb_client.test", "organization_id"),
+					resource.TestCheckResourceAttrPair("data.inferadb_client.test", "vault_id", "inferadb_client.test", "vault_id"),
+					resource.TestCheckResourceAttrPair("data.inferadb_client.test", "is_active", "inferadb_client.test", "is_active"),
+					resource.TestCheckResourceAttrPair("data.inferadb_client.test", "created_at", "inferadb_client.test", "created_at"),
+				),
+			},
+		},
+	}
+}
+
+func testAccClientDataSourceConfig(orgName string) string {
+	return fmt.Sprintf(`
+resource "inferadb_organization" "test" {
+  name = %[1]q
+  tier = "dev"
+}
+
+resource "inferadb_vault" "test" {
+  organization_id = inferadb_organization.test.id
+  name            = "test-vault"
+}
+
+resource "inferadb_client" "test" {
+  organization_id = inferadb_organization.test.id
+  vault_id        = inferadb_vault.test.id
+  name            = "test-client"
+}
+
+data "inferadb_client" "test" {
+  organization_id = inferadb_organization.test.id
+  id              = inferadb_client.test.id
+}
+`, orgName)
+}
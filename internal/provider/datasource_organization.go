@@ -26,11 +26,11 @@ type OrganizationDataSource struct {
 
 // OrganizationDataSourceModel describes the data source data model.
 type OrganizationDataSourceModel struct {
-	ID          types.String `tfsdk:"id"`
-	Name        types.String `tfsdk:"name"`
-	Tier        types.String `tfsdk:"tier"`
-	CreatedAt   types.String `tfsdk:"created_at"`
-	SuspendedAt types.String `tfsdk:"suspended_at"`
+	ID          SnowflakeIDValue `tfsdk:"id"`
+	Name        types.String     `tfsdk:"name"`
+	Tier        types.String     `tfsdk:"tier"`
+	CreatedAt   TimestampValue   `tfsdk:"created_at"`
+	SuspendedAt TimestampValue   `tfsdk:"suspended_at"`
 }
 
 // NewOrganizationDataSource is a helper function to simplify the provider implementation.
@@ -63,6 +63,7 @@ output "org_name" {
 ` + "```",
 		Attributes: map[string]schema.Attribute{
 			"id": schema.StringAttribute{
+				CustomType:          SnowflakeIDType{},
 				MarkdownDescription: "Snowflake ID of the organization.",
 				Required:            true,
 			},
@@ -75,10 +76,12 @@ output "org_name" {
 				Computed:            true,
 			},
 			"created_at": schema.StringAttribute{
+				CustomType:          TimestampType{},
 				MarkdownDescription: "ISO 8601 timestamp when the organization was created.",
 				Computed:            true,
 			},
 			"suspended_at": schema.StringAttribute{
+				CustomType:          TimestampType{},
 				MarkdownDescription: "ISO 8601 timestamp when the organization was suspended, if applicable.",
 				Computed:            true,
 			},
@@ -117,22 +120,19 @@ func (d *OrganizationDataSource) Read(ctx context.Context, req datasource.ReadRe
 	// Get organization from API
 	org, err := d.client.GetOrganization(ctx, data.ID.ValueString())
 	if err != nil {
-		resp.Diagnostics.AddError(
-			"Error reading organization",
-			fmt.Sprintf("Could not read organization %s: %s", data.ID.ValueString(), err.Error()),
-		)
+		resp.Diagnostics.Append(dataSourceReadError("organization", data.ID.ValueString(), err)...)
 		return
 	}
 
 	// Map response to data model
-	data.ID = types.StringValue(org.ID.String())
+	data.ID = NewSnowflakeIDValue(org.ID.String())
 	data.Name = types.StringValue(org.Name)
 	data.Tier = types.StringValue(org.Tier)
-	data.CreatedAt = types.StringValue(org.CreatedAt)
+	data.CreatedAt = NewTimestampValue(org.CreatedAt)
 	if org.SuspendedAt != nil && *org.SuspendedAt != "" {
-		data.SuspendedAt = types.StringValue(*org.SuspendedAt)
+		data.SuspendedAt = NewTimestampValue(*org.SuspendedAt)
 	} else {
-		data.SuspendedAt = types.StringNull()
+		data.SuspendedAt = NewTimestampNull()
 	}
 
 	// Save data into Terraform state
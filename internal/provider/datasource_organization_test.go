@@ -0,0 +1,54 @@
+// Copyright 2025 InferaDB
+// SPDX-License-Identifier: Apache-2.0
+
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccOrganizationDataSource(t *testing.T) {
+	testCase := testOrganizationDataSourceCase()
+	testCase.PreCheck = func() { testAccPreCheck(t) }
+	resource.Test(t, testCase)
+}
+
+func TestOrganizationDataSource(t *testing.T) {
+	testFakeControl(t)
+	resource.UnitTest(t, testOrganizationDataSourceCase())
+}
+
+func testOrganizationDataSourceCase() resource.TestCase {
+	rName := acctest.RandomWithPrefix("tf-test")
+
+	return resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccOrganizationDataSourceConfig(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair("data.inferadb_organization.test", "name", "inferadb_organization.test", "name"),
+					resource.TestCheckResourceAttrPair("data.inferadb_organization.test", "tier", "inferadb_organization.test", "tier"),
+					resource.TestCheckResourceAttrPair("data.inferadb_organization.test", "created_at", "inferadb_organization.test", "created_at"),
+				),
+			},
+		},
+	}
+}
+
+func testAccOrganizationDataSourceConfig(orgName string) string {
+	return fmt.Sprintf(`
+resource "inferadb_organization" "test" {
+  name = %[1]q
+  tier = "dev"
+}
+
+data "inferadb_organization" "test" {
+  id = inferadb_organization.test.id
+}
+`, orgName)
+}
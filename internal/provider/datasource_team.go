@@ -26,11 +26,11 @@ type TeamDataSource struct {
 
 // TeamDataSourceModel describes the data source data model.
 type TeamDataSourceModel struct {
-	ID             types.String `tfsdk:"id"`
-	OrganizationID types.String `tfsdk:"organization_id"`
-	Name           types.String `tfsdk:"name"`
-	Description    types.String `tfsdk:"description"`
-	CreatedAt      types.String `tfsdk:"created_at"`
+	ID             SnowflakeIDValue `tfsdk:"id"`
+	OrganizationID SnowflakeIDValue `tfsdk:"organization_id"`
+	Name           types.String     `tfsdk:"name"`
+	Description    types.String     `tfsdk:"description"`
+	CreatedAt      TimestampValue   `tfsdk:"created_at"`
 }
 
 // NewTeamDataSource is a helper function to simplify the provider implementation.
@@ -64,10 +64,12 @@ output "team_name" {
 ` + "```",
 		Attributes: map[string]schema.Attribute{
 			"id": schema.StringAttribute{
+				CustomType:          SnowflakeIDType{},
 				MarkdownDescription: "Snowflake ID of the team.",
 				Required:            true,
 			},
 			"organization_id": schema.StringAttribute{
+				CustomType:          SnowflakeIDType{},
 				MarkdownDescription: "Snowflake ID of the organization that owns this team.",
 				Required:            true,
 			},
@@ -80,6 +82,7 @@ output "team_name" {
 				Computed:            true,
 			},
 			"created_at": schema.StringAttribute{
+				CustomType:          TimestampType{},
 				MarkdownDescription: "ISO 8601 timestamp when the team was created.",
 				Computed:            true,
 			},
@@ -118,19 +121,16 @@ func (d *TeamDataSource) Read(ctx context.Context, req datasource.ReadRequest, r
 	// Get team from API
 	team, err := d.client.GetTeam(ctx, data.OrganizationID.ValueString(), data.ID.ValueString())
 	if err != nil {
-		resp.Diagnostics.AddError(
-			"Error reading team",
-			fmt.Sprintf("Could not read team %s: %s", data.ID.ValueString(), err.Error()),
-		)
+		resp.Diagnostics.Append(dataSourceReadError("team", data.ID.ValueString(), err)...)
 		return
 	}
 
 	// Map response to data model
-	data.ID = types.StringValue(team.ID.String())
-	data.OrganizationID = types.StringValue(team.OrganizationID.String())
+	data.ID = NewSnowflakeIDValue(team.ID.String())
+	data.OrganizationID = NewSnowflakeIDValue(team.OrganizationID.String())
 	data.Name = types.StringValue(team.Name)
 	data.Description = types.StringValue(team.Description)
-	data.CreatedAt = types.StringValue(team.CreatedAt)
+	data.CreatedAt = NewTimestampValue(team.CreatedAt)
 
 	// Save data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
@@ -0,0 +1,62 @@
+// Copyright 2025 InferaDB
+// SPDX-License-Identifier: Apache-2.0
+
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccTeamDataSource(t *testing.T) {
+	testCase := testTeamDataSourceCase()
+	testCase.PreCheck = func() { testAccPreCheck(t) }
+	resource.Test(t, testCase)
+}
+
+func TestTeamDataSource(t *testing.T) {
+	testFakeControl(t)
+	resource.UnitTest(t, testTeamDataSourceCase())
+}
+
+func testTeamDataSourceCase() resource.TestCase {
+	rName := acctest.RandomWithPrefix("tf-test")
+
+	return resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTeamDataSourceConfig(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair("data.inferadb_team.test", "name", "inferadb_team.test", "name"),
+					resource.TestCheckResourceAttrPair("data.inferadb_team.test", "organization_id", "inferadb_team.test", "organization_id"),
+					resource.TestCheckResourceAttrPair("data.inferadb_team.test", "description", "inferadb_team.test", "description"),
+					resource.TestCheckResourceAttrPair("data.inferadb_team.test", "created_at", "inferadb_team.test", "created_at"),
+				),
+			},
+		},
+	}
+}
+
+func testAccTeamDataSourceConfig(orgName string) string {
+	return fmt.Sprintf(`
+resource "inferadb_organization" "test" {
+  name = %[1]q
+  tier = "dev"
+}
+
+resource "inferadb_team" "test" {
+  organization_id = inferadb_organization.test.id
+  name            = "test-team"
+  description     = "Test team description"
+}
+
+data "inferadb_team" "test" {
+  organization_id = inferadb_organization.test.id
+  id              = inferadb_team.test.id
+}
+`, orgName)
+}
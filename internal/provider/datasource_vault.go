@@ -26,12 +26,12 @@ type VaultDataSource struct {
 
 // VaultDataSourceModel describes the data source data model.
 type VaultDataSourceModel struct {
-	ID             types.String `tfsdk:"id"`
-	OrganizationID types.String `tfsdk:"organization_id"`
-	Name           types.String `tfsdk:"name"`
-	Description    types.String `tfsdk:"description"`
-	SyncStatus     types.String `tfsdk:"sync_status"`
-	CreatedAt      types.String `tfsdk:"created_at"`
+	ID             SnowflakeIDValue `tfsdk:"id"`
+	OrganizationID SnowflakeIDValue `tfsdk:"organization_id"`
+	Name           types.String     `tfsdk:"name"`
+	Description    types.String     `tfsdk:"description"`
+	SyncStatus     types.String     `tfsdk:"sync_status"`
+	CreatedAt      TimestampValue   `tfsdk:"created_at"`
 }
 
 // NewVaultDataSource is a helper function to simplify the provider implementation.
@@ -65,10 +65,12 @@ output "vault_name" {
 ` + "```",
 		Attributes: map[string]schema.Attribute{
 			"id": schema.StringAttribute{
+				CustomType:          SnowflakeIDType{},
 				MarkdownDescription: "Snowflake ID of the vault.",
 				Required:            true,
 			},
 			"organization_id": schema.StringAttribute{
+				CustomType:          SnowflakeIDType{},
 				MarkdownDescription: "Snowflake ID of the organization that owns this vault.",
 				Required:            true,
 			},
@@ -85,6 +87,7 @@ output "vault_name" {
 				Computed:            true,
 			},
 			"created_at": schema.StringAttribute{
+				CustomType:          TimestampType{},
 				MarkdownDescription: "ISO 8601 timestamp when the vault was created.",
 				Computed:            true,
 			},
@@ -123,16 +126,13 @@ func (d *VaultDataSource) Read(ctx context.Context, req datasource.ReadRequest,
 	// Get vault from API
 	vault, err := d.client.GetVault(ctx, data.OrganizationID.ValueString(), data.ID.ValueString())
 	if err != nil {
-		resp.Diagnostics.AddError(
-			"Error reading vault",
-			fmt.Sprintf("Could not read vault %s: %s", data.ID.ValueString(), err.Error()),
-		)
+		resp.Diagnostics.Append(dataSourceReadError("vault", data.ID.ValueString(), err)...)
 		return
 	}
 
 	// Map response to data model
-	data.ID = types.StringValue(vault.ID.String())
-	data.OrganizationID = types.StringValue(vault.OrganizationID.String())
+	data.ID = NewSnowflakeIDValue(vault.ID.String())
+	data.OrganizationID = NewSnowflakeIDValue(vault.OrganizationID.String())
 	data.Name = types.StringValue(vault.Name)
 	data.Description = types.StringValue(vault.Description)
 	if vault.SyncStatus != "" {
@@ -140,7 +140,7 @@ func (d *VaultDataSource) Read(ctx context.Context, req datasource.ReadRequest,
 	} else {
 		data.SyncStatus = types.StringNull()
 	}
-	data.CreatedAt = types.StringValue(vault.CreatedAt)
+	data.CreatedAt = NewTimestampValue(vault.CreatedAt)
 
 	// Save data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
@@ -0,0 +1,62 @@
+// Copyright 2025 InferaDB
+// SPDX-License-Identifier: Apache-2.0
+
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccVaultDataSource(t *testing.T) {
+	testCase := testVaultDataSourceCase()
+	testCase.PreCheck = func() { testAccPreCheck(t) }
+	resource.Test(t, testCase)
+}
+
+func TestVaultDataSource(t *testing.T) {
+	testFakeControl(t)
+	resource.UnitTest(t, testVaultDataSourceCase())
+}
+
+func testVaultDataSourceCase() resource.TestCase {
+	rName := acctest.RandomWithPrefix("tf-test")
+
+	return resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVaultDataSourceConfig(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair("data.inferadb_vault.test", "name", "inferadb_vault.test", "name"),
+					resource.TestCheckResourceAttrPair("data.inferadb_vault.test", "organization_id", "inferadb_vault.test", "organization_id"),
+					resource.TestCheckResourceAttrPair("data.inferadb_vault.test", "sync_status", "inferadb_vault.test", "sync_status"),
+					resource.TestCheckResourceAttrPair("data.inferadb_vault.test", "created_at", "inferadb_vault.test", "created_at"),
+				),
+			},
+		},
+	}
+}
+
+func testAccVaultDataSourceConfig(orgName string) string {
+	return fmt.Sprintf(`
+resource "inferadb_organization" "test" {
+  name = %[1]q
+  tier = "dev"
+}
+
+resource "inferadb_vault" "test" {
+  organization_id = inferadb_organization.test.id
+  name            = "test-vault"
+  description     = "Test vault description"
+}
+
+data "inferadb_vault" "test" {
+  organization_id = inferadb_organization.test.id
+  id              = inferadb_vault.test.id
+}
+`, orgName)
+}
@@ -0,0 +1,29 @@
+// Copyright 2025 InferaDB
+// SPDX-License-Identifier: Apache-2.0
+
+package provider
+
+import (
+	"errors"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/inferadb/terraform-provider-inferadb/internal/client"
+)
+
+// addClientError adds the error diagnostic for a failed Control API call.
+// Once the API has rejected the provider credentials, every failing
+// operation reports the diagnostic of the first rejection rather than its
+// own error, so an expired session surfaces as one identical diagnostic
+// instead of N different ones.
+func addClientError(diags *diag.Diagnostics, summary, detail string, err error) {
+	var authErr *client.AuthError
+	if errors.As(err, &authErr) {
+		detail := "InferaDB rejected the provider credentials: " + authErr.Err.Error()
+		if authErr.Hint != "" {
+			detail += ". " + authErr.Hint
+		}
+		diags.AddError("Authentication Failed", detail)
+		return
+	}
+	diags.AddError(summary, detail)
+}
@@ -0,0 +1,35 @@
+// Copyright 2025 InferaDB
+// SPDX-License-Identifier: Apache-2.0
+
+package provider
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/inferadb/terraform-provider-inferadb/internal/client"
+)
+
+func TestAddClientErrorReportsAuthFailuresAlike(t *testing.T) {
+	first := &client.AuthError{Err: errors.New("InferaDB API error (401): session expired"), Hint: "Run `inferadb login`"}
+	repeated := &client.AuthError{Err: first.Err, Hint: first.Hint, Repeated: true}
+
+	var created, read diag.Diagnostics
+	addClientError(&created, "Error creating team", "Could not create team", fmt.Errorf("failed to create team: %w", first))
+	addClientError(&read, "Error reading vault", "Could not read vault 2", fmt.Errorf("failed to get vault: %w", repeated))
+
+	if !created.Equal(read) {
+		t.Errorf("diagnostics differ:\n%v\n%v", created, read)
+	}
+	if created[0].Summary() != "Authentication Failed" {
+		t.Errorf("summary = %q, want %q", created[0].Summary(), "Authentication Failed")
+	}
+
+	var other diag.Diagnostics
+	addClientError(&other, "Error reading vault", "Could not read vault 2", errors.New("boom"))
+	if other[0].Summary() != "Error reading vault" || other[0].Detail() != "Could not read vault 2" {
+		t.Errorf("diagnostic = %v, want the given summary and detail", other)
+	}
+}
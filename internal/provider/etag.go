@@ -0,0 +1,67 @@
+// Copyright 2025 InferaDB
+// SPDX-License-Identifier: Apache-2.0
+
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+)
+
+// etagPrivateKey is the private state key holding the ETag of the object
+// version last read or written by Terraform. Updates send it as If-Match so
+// changes made outside Terraform since the last refresh are not overwritten.
+const etagPrivateKey = "etag"
+
+// privateStateGetter is implemented by the Private field of resource requests.
+type privateStateGetter interface {
+	GetKey(ctx context.Context, key string) ([]byte, diag.Diagnostics)
+}
+
+// privateStateSetter is implemented by the Private field of resource responses.
+type privateStateSetter interface {
+	SetKey(ctx context.Context, key string, value []byte) diag.Diagnostics
+}
+
+// getETag returns the ETag stored in private state, or "" when none is.
+func getETag(ctx context.Context, private privateStateGetter) (string, diag.Diagnostics) {
+	data, diags := private.GetKey(ctx, etagPrivateKey)
+	if len(data) == 0 {
+		return "", diags
+	}
+
+	var etag string
+	if err := json.Unmarshal(data, &etag); err != nil {
+		// An unreadable ETag only disables the concurrency check.
+		return "", diags
+	}
+	return etag, diags
+}
+
+// setETag stores etag in private state. An empty etag removes the key.
+func setETag(ctx context.Context, private privateStateSetter, etag string) diag.Diagnostics {
+	if etag == "" {
+		return private.SetKey(ctx, etagPrivateKey, nil)
+	}
+
+	data, err := json.Marshal(etag)
+	if err != nil {
+		var diags diag.Diagnostics
+		diags.AddError("Unable to Store ETag", err.Error())
+		return diags
+	}
+	return private.SetKey(ctx, etagPrivateKey, data)
+}
+
+// addChangedOutsideTerraformError reports a conditional update rejected
+// because the object changed since Terraform last read it.
+func addChangedOutsideTerraformError(diags *diag.Diagnostics, kind, id string) {
+	diags.AddError(
+		fmt.Sprintf("%s Changed Outside Terraform", kind),
+		fmt.Sprintf("The %s with ID %s was modified outside Terraform since it was last refreshed, so the update was not applied to avoid overwriting those changes. "+
+			"Run terraform plan or terraform apply again to refresh the %s and review the differences.", kind, id, kind),
+	)
+}
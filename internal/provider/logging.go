@@ -0,0 +1,51 @@
+// Copyright 2025 InferaDB
+// SPDX-License-Identifier: Apache-2.0
+
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/inferadb/terraform-provider-inferadb/internal/client"
+)
+
+// clientLogSubsystem is the tflog subsystem of Control API requests, enabled
+// with TF_LOG=DEBUG or TF_LOG=TRACE (or TF_LOG_PROVIDER_INFERADB_CLIENT).
+const clientLogSubsystem = "client"
+
+// tflogLogger writes Control API requests to the provider log. Requests are
+// logged at DEBUG; headers and bodies, already redacted by the client, are
+// only logged at TRACE.
+type tflogLogger struct{}
+
+var _ client.Logger = tflogLogger{}
+
+// LogRequest implements client.Logger.
+func (tflogLogger) LogRequest(ctx context.Context, entry client.RequestLog) {
+	ctx = tflog.NewSubsystem(ctx, clientLogSubsystem)
+
+	fields := map[string]interface{}{
+		"http_method":      entry.Method,
+		"http_path":        entry.Path,
+		"http_attempt":     entry.Attempt + 1,
+		"http_duration_ms": entry.Duration.Milliseconds(),
+	}
+	if entry.Err != nil {
+		fields["error"] = entry.Err.Error()
+		tflog.SubsystemDebug(ctx, clientLogSubsystem, "Control API request failed", fields)
+		return
+	}
+	fields["http_status"] = entry.StatusCode
+	tflog.SubsystemDebug(ctx, clientLogSubsystem, "Control API request", fields)
+
+	tflog.SubsystemTrace(ctx, clientLogSubsystem, "Control API request details", map[string]interface{}{
+		"http_method":           entry.Method,
+		"http_path":             entry.Path,
+		"http_request_headers":  entry.RequestHeaders,
+		"http_request_body":     entry.RequestBody,
+		"http_status":           entry.StatusCode,
+		"http_response_headers": entry.ResponseHeaders,
+		"http_response_body":    entry.ResponseBody,
+	})
+}
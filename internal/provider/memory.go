@@ -0,0 +1,9 @@
+// Copyright 2025 InferaDB
+// SPDX-License-Identifier: Apache-2.0
+
+package provider
+
+// memoryEndpoint is the endpoint that selects the in-process fake Control
+// API instead of a real service. The fake is only compiled into builds with
+// the memory build tag, so release binaries do not carry it.
+const memoryEndpoint = "memory://"
@@ -0,0 +1,12 @@
+// Copyright 2025 InferaDB
+// SPDX-License-Identifier: Apache-2.0
+
+//go:build !memory
+
+package provider
+
+// memoryControl reports that this build has no fake Control API behind
+// memoryEndpoint.
+func memoryControl() (url, sessionToken string, ok bool) {
+	return "", "", false
+}
@@ -0,0 +1,28 @@
+// Copyright 2025 InferaDB
+// SPDX-License-Identifier: Apache-2.0
+
+//go:build memory
+
+package provider
+
+import (
+	"sync"
+
+	"github.com/inferadb/terraform-provider-inferadb/internal/fakecontrol"
+)
+
+var (
+	memoryControlOnce sync.Once
+	memoryControlSrv  *fakecontrol.Server
+)
+
+// memoryControl returns the URL and session token of the fake Control API
+// behind memoryEndpoint. It is started on first use and lives as long as the
+// provider process, so every Configure call of a Terraform run sees the same
+// objects.
+func memoryControl() (url, sessionToken string, ok bool) {
+	memoryControlOnce.Do(func() {
+		memoryControlSrv = fakecontrol.New(fakecontrol.Options{})
+	})
+	return memoryControlSrv.URL, memoryControlSrv.SessionToken, true
+}
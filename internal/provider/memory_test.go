@@ -0,0 +1,111 @@
+// Copyright 2025 InferaDB
+// SPDX-License-Identifier: Apache-2.0
+
+//go:build memory
+
+package provider
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/inferadb/terraform-provider-inferadb/internal/client"
+)
+
+func TestMemoryEndpoint(t *testing.T) {
+	t.Setenv("INFERADB_SESSION_TOKEN", "")
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+provider "inferadb" {
+  endpoint = "memory://"
+}
+
+resource "inferadb_organization" "test" {
+  name = "memory-test"
+  tier = "pro"
+}
+
+resource "inferadb_vault" "test" {
+  organization_id = inferadb_organization.test.id
+  name            = "memory-vault"
+}
+
+data "inferadb_vault" "test" {
+  organization_id = inferadb_organization.test.id
+  id              = inferadb_vault.test.id
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestMatchResourceAttr("inferadb_organization.test", "id", regexp.MustCompile(`^[0-9]+$`)),
+					resource.TestCheckResourceAttr("inferadb_organization.test", "tier", "pro"),
+					resource.TestCheckResourceAttr("inferadb_vault.test", "sync_status", "synced"),
+					resource.TestCheckResourceAttrPair("data.inferadb_vault.test", "name", "inferadb_vault.test", "name"),
+				),
+			},
+			// Objects that do not exist are reported as not found
+			{
+				Config: `
+provider "inferadb" {
+  endpoint = "memory://"
+}
+
+data "inferadb_organization" "missing" {
+  id = "1"
+}
+`,
+				ExpectError: regexp.MustCompile(`InferaDB API error \(404\)`),
+			},
+		},
+	})
+}
+
+// The memory:// endpoint must answer with the response shapes of the real
+// service, including the bare arrays of the unpaginated list routes.
+func TestMemoryEndpointResponseShapes(t *testing.T) {
+	memoryURL, memoryToken, ok := memoryControl()
+	if !ok {
+		t.Fatal("memoryControl reports no fake Control API")
+	}
+	c := client.New(client.Config{Endpoint: memoryURL, SessionToken: memoryToken, CacheTTL: -1})
+	ctx := context.Background()
+
+	org, err := c.CreateOrganization(ctx, client.CreateOrganizationRequest{Name: "memory-shapes"})
+	if err != nil {
+		t.Fatalf("CreateOrganization: %v", err)
+	}
+	team, err := c.CreateTeam(ctx, org.ID.String(), client.CreateTeamRequest{Name: "memory-shapes"})
+	if err != nil {
+		t.Fatalf("CreateTeam: %v", err)
+	}
+
+	orgPath := "/v1/organizations/" + org.ID.String()
+	for path, bare := range map[string]bool{
+		"/v1/organizations": false,
+		orgPath + "/teams":  false,
+		orgPath + "/teams/" + team.ID.String() + "/members": true,
+	} {
+		req, err := http.NewRequest(http.MethodGet, memoryURL+path, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		req.AddCookie(&http.Cookie{Name: "infera_session", Value: memoryToken})
+		resp, err := http.DefaultClient.Do(req)
+		if err != nil {
+			t.Fatal(err)
+		}
+		body, _ := io.ReadAll(resp.Body)
+		_ = resp.Body.Close()
+		if got := strings.HasPrefix(strings.TrimSpace(string(body)), "["); got != bare {
+			t.Errorf("GET %s = %s, bare array = %t, want %t", path, body, got, bare)
+		}
+	}
+}
@@ -0,0 +1,65 @@
+// Copyright 2025 InferaDB
+// SPDX-License-Identifier: Apache-2.0
+
+package provider
+
+import (
+	"errors"
+	"fmt"
+	"os"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/inferadb/terraform-provider-inferadb/internal/credentials"
+)
+
+// loadProfile reads the profile selected by the provider configuration from
+// the shared credentials file. It returns nil without error when neither a
+// profile nor a credentials file was requested and the default file or its
+// default profile does not exist.
+func loadProfile(config InferaDBProviderModel) (*credentials.Profile, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	name := stringWithEnv(config.Profile, "INFERADB_PROFILE")
+	file := stringWithEnv(config.CredentialsFile, "INFERADB_CREDENTIALS_FILE")
+	explicit := name != "" || file != ""
+
+	if name == "" {
+		name = credentials.DefaultProfile
+	}
+	if file == "" {
+		defaultPath, err := credentials.DefaultPath()
+		if err != nil {
+			if explicit {
+				diags.AddAttributeError(
+					path.Root("credentials_file"),
+					"Unable to Locate Credentials File",
+					fmt.Sprintf("Could not determine the default credentials file location: %s", err),
+				)
+			}
+			return nil, diags
+		}
+		file = defaultPath
+	}
+
+	profile, err := credentials.LoadProfile(file, name)
+	switch {
+	case err == nil:
+		return profile, diags
+	case !explicit && (errors.Is(err, os.ErrNotExist) || errors.Is(err, credentials.ErrProfileNotFound)):
+		return nil, diags
+	case errors.Is(err, credentials.ErrProfileNotFound):
+		diags.AddAttributeError(
+			path.Root("profile"),
+			"Profile Not Found",
+			fmt.Sprintf("The credentials file has no profile %q: %s. Run `inferadb login` for that profile or select another one.", name, err),
+		)
+	default:
+		diags.AddAttributeError(
+			path.Root("credentials_file"),
+			"Unable to Read Credentials File",
+			fmt.Sprintf("Could not read profile %q: %s", name, err),
+		)
+	}
+	return nil, diags
+}
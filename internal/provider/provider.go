@@ -6,9 +6,14 @@ package provider
 
 import (
 	"context"
+	"fmt"
 	"os"
+	"strconv"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
@@ -28,6 +33,19 @@ type InferaDBProvider struct {
 type InferaDBProviderModel struct {
 	Endpoint     types.String `tfsdk:"endpoint"`
 	SessionToken types.String `tfsdk:"session_token"`
+	MaxRetries   types.Int64  `tfsdk:"max_retries"`
+	RetryMaxWait types.Int64  `tfsdk:"retry_max_wait"`
+
+	Profile         types.String `tfsdk:"profile"`
+	CredentialsFile types.String `tfsdk:"credentials_file"`
+
+	RequestsPerSecond     types.Float64 `tfsdk:"requests_per_second"`
+	MaxConcurrentRequests types.Int64   `tfsdk:"max_concurrent_requests"`
+
+	PageSize types.Int64 `tfsdk:"page_size"`
+
+	Auth      *AuthModel      `tfsdk:"auth"`
+	Transport *TransportModel `tfsdk:"transport"`
 }
 
 // New creates a new provider instance.
@@ -57,6 +75,62 @@ either in the provider configuration or via the ` + "`INFERADB_SESSION_TOKEN`" +
 
 To obtain a session token, log in via the InferaDB CLI or web dashboard.
 
+By default the token is sent as an ` + "`infera_session`" + ` cookie. Use the ` + "`auth`" + ` block to send it
+as a bearer token or in a custom header instead, for example when the Control API sits behind an API gateway:
+
+` + "```hcl" + `
+provider "inferadb" {
+  endpoint = "https://gateway.example.com/inferadb"
+
+  auth {
+    type  = "bearer"
+    token = var.gateway_token
+  }
+}
+` + "```" + `
+
+Automation can authenticate as an ` + "`inferadb_client`" + ` service account. The provider signs a short-lived JWT
+with one of the client's certificates, exchanges it for an access token and refreshes it before it expires:
+
+` + "```hcl" + `
+provider "inferadb" {
+  auth {
+    client_id        = var.inferadb_client_id
+    certificate_kid  = var.inferadb_certificate_kid
+    private_key_file = "/secrets/inferadb-ci.pem"
+  }
+}
+` + "```" + `
+
+Engineers who ran ` + "`inferadb login`" + ` can use the shared credentials file instead of exporting the token.
+Select a profile with ` + "`profile`" + ` or ` + "`INFERADB_PROFILE`" + `:
+
+` + "```hcl" + `
+provider "inferadb" {
+  profile = "staging"
+}
+` + "```" + `
+
+Settings are resolved in this order, highest precedence first:
+
+1. Provider configuration (` + "`endpoint`" + `, ` + "`session_token`" + `)
+2. Environment variables (` + "`INFERADB_ENDPOINT`" + `, ` + "`INFERADB_SESSION_TOKEN`" + `)
+3. The selected profile of the credentials file (` + "`~/.inferadb/credentials`" + ` by default)
+4. Defaults
+
+## Offline Testing
+
+Set ` + "`endpoint`" + ` to ` + "`memory://`" + ` to run ` + "`terraform plan`" + `, ` + "`terraform apply`" + ` and ` + "`terraform test`" + ` without
+credentials or network access. The provider then serves an in-memory fake of the Control API that generates
+snowflake IDs, normalizes tiers and answers 404 for missing objects like the real service. Its state is
+discarded when the provider process exits.
+
+` + "```hcl" + `
+provider "inferadb" {
+  endpoint = "memory://"
+}
+` + "```" + `
+
 ## Example Usage
 
 ` + "```hcl" + `
@@ -78,7 +152,7 @@ resource "inferadb_vault" "production" {
 `,
 		Attributes: map[string]schema.Attribute{
 			"endpoint": schema.StringAttribute{
-				MarkdownDescription: "InferaDB Control API endpoint. Can also be set via `INFERADB_ENDPOINT` environment variable. Defaults to `https://api.inferadb.com`.",
+				MarkdownDescription: "InferaDB Control API endpoint. Can also be set via `INFERADB_ENDPOINT` environment variable. Defaults to `https://api.inferadb.com`. Set to `memory://` to run against an in-process fake of the Control API that needs no credentials and keeps its state for the lifetime of the provider process; this requires a provider built with the `memory` build tag.",
 				Optional:            true,
 			},
 			"session_token": schema.StringAttribute{
@@ -86,6 +160,38 @@ resource "inferadb_vault" "production" {
 				Optional:            true,
 				Sensitive:           true,
 			},
+			"profile": schema.StringAttribute{
+				MarkdownDescription: "Named profile of the shared credentials file to read `endpoint` and `session_token` from. Can also be set via `INFERADB_PROFILE` environment variable. Defaults to `default`.",
+				Optional:            true,
+			},
+			"credentials_file": schema.StringAttribute{
+				MarkdownDescription: "Path to the shared credentials file written by `inferadb login`. Can also be set via `INFERADB_CREDENTIALS_FILE` environment variable. Defaults to `~/.inferadb/credentials`.",
+				Optional:            true,
+			},
+			"max_retries": schema.Int64Attribute{
+				MarkdownDescription: "Maximum number of times a request is retried after a transient failure (connection errors, `429`, `502`, `503`, `504`). Set to `0` to disable retries. Defaults to `3`.",
+				Optional:            true,
+			},
+			"retry_max_wait": schema.Int64Attribute{
+				MarkdownDescription: "Maximum number of seconds to wait between two attempts, including delays requested by the API through `Retry-After`. Defaults to `30`.",
+				Optional:            true,
+			},
+			"requests_per_second": schema.Float64Attribute{
+				MarkdownDescription: "Maximum sustained rate of requests sent to the Control API. Short bursts of up to one second worth of requests are allowed. Unlimited by default.",
+				Optional:            true,
+			},
+			"max_concurrent_requests": schema.Int64Attribute{
+				MarkdownDescription: "Maximum number of requests in flight at once. The provider lowers this bound automatically while the API answers with `429 Too Many Requests` and raises it again as requests succeed. Defaults to `10`.",
+				Optional:            true,
+			},
+			"page_size": schema.Int64Attribute{
+				MarkdownDescription: "Number of items requested per page when listing, e.g. the grants of a vault. Every page is fetched regardless; a larger page size means fewer requests. Can also be set via `INFERADB_PAGE_SIZE` environment variable. Defaults to `100`.",
+				Optional:            true,
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"auth":      authBlock(),
+			"transport": transportBlock(),
 		},
 	}
 }
@@ -99,10 +205,23 @@ func (p *InferaDBProvider) Configure(ctx context.Context, req provider.Configure
 		return
 	}
 
-	// Default values with environment variable fallback
+	// Default values, overridden in turn by the selected credentials profile,
+	// environment variables and the provider configuration
 	endpoint := "https://api.inferadb.com"
 	sessionToken := ""
 
+	profile, diags := loadProfile(config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if profile != nil {
+		if profile.Endpoint != "" {
+			endpoint = profile.Endpoint
+		}
+		sessionToken = profile.SessionToken
+	}
+
 	if envEndpoint := os.Getenv("INFERADB_ENDPOINT"); envEndpoint != "" {
 		endpoint = envEndpoint
 	}
@@ -118,21 +237,122 @@ func (p *InferaDBProvider) Configure(ctx context.Context, req provider.Configure
 		sessionToken = config.SessionToken.ValueString()
 	}
 
-	// Validate required configuration
-	if sessionToken == "" {
-		resp.Diagnostics.AddError(
-			"Missing Session Token",
-			"The provider requires a session token for authentication. "+
-				"Set the session_token in the provider configuration or "+
-				"set the INFERADB_SESSION_TOKEN environment variable.",
-		)
+	// Select how requests are authenticated. The memory endpoint is served
+	// in-process and needs no credentials.
+	var authenticator client.Authenticator
+	if endpoint == memoryEndpoint {
+		memoryURL, memoryToken, ok := memoryControl()
+		if !ok {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("endpoint"),
+				"Memory Endpoint Unavailable",
+				"This build of the provider does not include the in-memory fake Control API behind "+memoryEndpoint+". "+
+					"Build the provider with `go build -tags memory` to use it.",
+			)
+			return
+		}
+		endpoint = memoryURL
+		authenticator = client.SessionCookieAuth{Token: memoryToken}
+	} else {
+		authenticator, diags = newAuthenticator(config.Auth, sessionToken)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	// Retry settings; zero retries is translated to the client's "disabled" value
+	maxRetries := client.DefaultMaxRetries
+	if !config.MaxRetries.IsNull() {
+		maxRetries = int(config.MaxRetries.ValueInt64())
+		if maxRetries < 0 {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("max_retries"),
+				"Invalid Retry Configuration",
+				"max_retries must be zero or greater.",
+			)
+			return
+		}
+		if maxRetries == 0 {
+			maxRetries = -1
+		}
+	}
+
+	retryMaxWait := client.DefaultRetryMaxWait
+	if !config.RetryMaxWait.IsNull() {
+		if config.RetryMaxWait.ValueInt64() <= 0 {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("retry_max_wait"),
+				"Invalid Retry Configuration",
+				"retry_max_wait must be greater than zero.",
+			)
+			return
+		}
+		retryMaxWait = time.Duration(config.RetryMaxWait.ValueInt64()) * time.Second
+	}
+
+	// Client-side rate limiting
+	requestsPerSecond := 0.0
+	if !config.RequestsPerSecond.IsNull() {
+		requestsPerSecond = config.RequestsPerSecond.ValueFloat64()
+		if requestsPerSecond <= 0 {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("requests_per_second"),
+				"Invalid Rate Limit Configuration",
+				"requests_per_second must be greater than zero.",
+			)
+			return
+		}
+	}
+
+	maxInFlight := client.DefaultMaxInFlight
+	if !config.MaxConcurrentRequests.IsNull() {
+		maxInFlight = int(config.MaxConcurrentRequests.ValueInt64())
+		if maxInFlight <= 0 {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("max_concurrent_requests"),
+				"Invalid Rate Limit Configuration",
+				"max_concurrent_requests must be greater than zero.",
+			)
+			return
+		}
+	}
+
+	// Pagination of list requests
+	pageSize, diags := pageSizeSetting(config.PageSize)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Connection settings
+	transport, diags := newTransportSettings(ctx, config.Transport)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Regression tests record or replay Control API traffic through a cassette
+	roundTripper, err := client.RecorderFromEnv(transport.transport)
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid Recorder Configuration", err.Error())
 		return
 	}
 
 	// Create the API client
 	apiClient := client.New(client.Config{
-		Endpoint:     endpoint,
-		SessionToken: sessionToken,
+		Endpoint:          endpoint,
+		Transport:         roundTripper,
+		Timeout:           transport.timeout,
+		Headers:           transport.headers,
+		Logger:            tflogLogger{},
+		Authenticator:     authenticator,
+		ReauthHint:        reauthHint(authenticator),
+		MaxRetries:        maxRetries,
+		RetryMaxWait:      retryMaxWait,
+		RequestsPerSecond: requestsPerSecond,
+		MaxInFlight:       maxInFlight,
+		PageSize:          pageSize,
 	})
 
 	// Make the client available to data sources and resources
@@ -140,6 +360,38 @@ func (p *InferaDBProvider) Configure(ctx context.Context, req provider.Configure
 	resp.ResourceData = apiClient
 }
 
+// pageSizeSetting returns the page size of list requests: the page_size
+// attribute, else INFERADB_PAGE_SIZE, else client.DefaultPageSize.
+func pageSizeSetting(value types.Int64) (int, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	if !value.IsNull() {
+		if value.ValueInt64() <= 0 {
+			diags.AddAttributeError(
+				path.Root("page_size"),
+				"Invalid Pagination Configuration",
+				"page_size must be greater than zero.",
+			)
+			return 0, diags
+		}
+		return int(value.ValueInt64()), diags
+	}
+
+	if env := os.Getenv("INFERADB_PAGE_SIZE"); env != "" {
+		pageSize, err := strconv.Atoi(env)
+		if err != nil || pageSize <= 0 {
+			diags.AddError(
+				"Invalid Pagination Configuration",
+				fmt.Sprintf("INFERADB_PAGE_SIZE must be a whole number greater than zero, got %q.", env),
+			)
+			return 0, diags
+		}
+		return pageSize, diags
+	}
+
+	return client.DefaultPageSize, diags
+}
+
 // Resources defines the resources implemented in the provider.
 func (p *InferaDBProvider) Resources(ctx context.Context) []func() resource.Resource {
 	return []func() resource.Resource{
@@ -4,11 +4,16 @@
 package provider
 
 import (
+	"fmt"
 	"os"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/inferadb/terraform-provider-inferadb/internal/client"
+	"github.com/inferadb/terraform-provider-inferadb/internal/fakecontrol"
 )
 
 // testAccProtoV6ProviderFactories are used to instantiate a provider during
@@ -19,12 +24,85 @@ var testAccProtoV6ProviderFactories = map[string]func() (tfprotov6.ProviderServe
 	"inferadb": providerserver.NewProtocol6WithError(New("test")()),
 }
 
+// TestMain bootstraps the acceptance test environment, then runs the
+// sweepers when invoked with -sweep and the tests otherwise.
+func TestMain(m *testing.M) {
+	testEnvBootstrap()
+	if testEnvSkipReason != "" && testEnvRequired() {
+		fmt.Fprintf(os.Stderr, "INFERADB_REQUIRE_TEST_ENV is set but %s\n", testEnvSkipReason)
+		os.Exit(1)
+	}
+	resource.TestMain(m)
+}
+
 func testAccPreCheck(t *testing.T) {
+	skip := t.Skip
+	if testEnvRequired() {
+		skip = t.Fatal
+	}
+
+	if testEnvSkipReason != "" {
+		skip(testEnvSkipReason)
+	}
+
 	// Check that required environment variables are set
 	if os.Getenv("INFERADB_SESSION_TOKEN") == "" {
-		t.Fatal("INFERADB_SESSION_TOKEN must be set for acceptance tests")
+		skip("INFERADB_SESSION_TOKEN must be set for acceptance tests")
 	}
 	if os.Getenv("INFERADB_ENDPOINT") == "" {
-		t.Fatal("INFERADB_ENDPOINT must be set for acceptance tests")
+		skip("INFERADB_ENDPOINT must be set for acceptance tests")
+	}
+}
+
+// testFakeControl starts an in-memory fake of the Control API for the test
+// and points the provider at it through the environment. Test cases run
+// against it with resource.UnitTest, without TF_ACC or a Docker environment.
+func testFakeControl(t *testing.T) *fakecontrol.Server {
+	t.Helper()
+
+	srv := fakecontrol.New(fakecontrol.Options{})
+	t.Cleanup(srv.Close)
+
+	t.Setenv("INFERADB_ENDPOINT", srv.URL)
+	t.Setenv("INFERADB_SESSION_TOKEN", srv.SessionToken)
+	// Keep ambient credentials and profiles from overriding the session token.
+	for _, name := range []string{
+		"INFERADB_PROFILE", "INFERADB_CREDENTIALS_FILE", "INFERADB_CA_CERT_FILE",
+		"INFERADB_CLIENT_ID", "INFERADB_CERTIFICATE_KID", "INFERADB_PRIVATE_KEY_PEM", "INFERADB_PRIVATE_KEY_FILE",
+		"INFERADB_OIDC_TOKEN", "INFERADB_OIDC_TOKEN_FILE",
+	} {
+		t.Setenv(name, "")
+	}
+	return srv
+}
+
+func TestPageSizeSetting(t *testing.T) {
+	tests := []struct {
+		name    string
+		value   types.Int64
+		env     string
+		want    int
+		wantErr bool
+	}{
+		{name: "default", value: types.Int64Null(), want: client.DefaultPageSize},
+		{name: "environment", value: types.Int64Null(), env: "50", want: 50},
+		{name: "attribute over environment", value: types.Int64Value(20), env: "50", want: 20},
+		{name: "zero attribute", value: types.Int64Value(0), wantErr: true},
+		{name: "invalid environment", value: types.Int64Null(), env: "many", wantErr: true},
+		{name: "negative environment", value: types.Int64Null(), env: "-1", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Setenv("INFERADB_PAGE_SIZE", tt.env)
+
+			got, diags := pageSizeSetting(tt.value)
+			if diags.HasError() != tt.wantErr {
+				t.Fatalf("diagnostics = %v, want error: %t", diags, tt.wantErr)
+			}
+			if !tt.wantErr && got != tt.want {
+				t.Errorf("page size = %d, want %d", got, tt.want)
+			}
+		})
 	}
 }
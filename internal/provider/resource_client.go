@@ -6,14 +6,16 @@ package provider
 import (
 	"context"
 	"fmt"
-	"strings"
 
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/inferadb/terraform-provider-inferadb/internal/client"
 )
@@ -34,12 +36,13 @@ type ClientResource struct {
 
 // ClientResourceModel describes the resource data model.
 type ClientResourceModel struct {
-	ID             types.String `tfsdk:"id"`
-	OrganizationID types.String `tfsdk:"organization_id"`
-	VaultID        types.String `tfsdk:"vault_id"`
-	Name           types.String `tfsdk:"name"`
-	IsActive       types.Bool   `tfsdk:"is_active"`
-	CreatedAt      types.String `tfsdk:"created_at"`
+	ID             SnowflakeIDValue `tfsdk:"id"`
+	OrganizationID SnowflakeIDValue `tfsdk:"organization_id"`
+	VaultID        SnowflakeIDValue `tfsdk:"vault_id"`
+	Name           types.String     `tfsdk:"name"`
+	IsActive       types.Bool       `tfsdk:"is_active"`
+	CreatedAt      TimestampValue   `tfsdk:"created_at"`
+	Timeouts       timeouts.Value   `tfsdk:"timeouts"`
 }
 
 // Metadata sets the resource type name.
@@ -54,6 +57,7 @@ func (r *ClientResource) Schema(ctx context.Context, req resource.SchemaRequest,
 
 		Attributes: map[string]schema.Attribute{
 			"id": schema.StringAttribute{
+				CustomType:          SnowflakeIDType{},
 				MarkdownDescription: "Snowflake ID",
 				Computed:            true,
 				PlanModifiers: []planmodifier.String{
@@ -61,6 +65,7 @@ func (r *ClientResource) Schema(ctx context.Context, req resource.SchemaRequest,
 				},
 			},
 			"organization_id": schema.StringAttribute{
+				CustomType:          SnowflakeIDType{},
 				MarkdownDescription: "Parent organization ID",
 				Required:            true,
 				PlanModifiers: []planmodifier.String{
@@ -68,12 +73,14 @@ func (r *ClientResource) Schema(ctx context.Context, req resource.SchemaRequest,
 				},
 			},
 			"vault_id": schema.StringAttribute{
+				CustomType:          SnowflakeIDType{},
 				MarkdownDescription: "Default vault for token generation",
 				Required:            true,
 			},
 			"name": schema.StringAttribute{
 				MarkdownDescription: "Client name",
 				Required:            true,
+				Validators:          []validator.String{stringvalidator.LengthAtLeast(1)},
 			},
 			"is_active": schema.BoolAttribute{
 				MarkdownDescription: "Whether client is active",
@@ -83,6 +90,7 @@ func (r *ClientResource) Schema(ctx context.Context, req resource.SchemaRequest,
 				},
 			},
 			"created_at": schema.StringAttribute{
+				CustomType:          TimestampType{},
 				MarkdownDescription: "ISO 8601 timestamp",
 				Computed:            true,
 				PlanModifiers: []planmodifier.String{
@@ -90,6 +98,9 @@ func (r *ClientResource) Schema(ctx context.Context, req resource.SchemaRequest,
 				},
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeoutsBlock(ctx, defaultTimeouts),
+		},
 	}
 }
 
@@ -121,20 +132,27 @@ func (r *ClientResource) Create(ctx context.Context, req resource.CreateRequest,
 		return
 	}
 
+	ctx, cancel, diags := withOperationTimeout(ctx, data.Timeouts, opCreate, defaultTimeouts)
+	defer cancel()
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Create the client
 	inferaClient, err := r.client.CreateClient(ctx, data.OrganizationID.ValueString(), client.CreateClientRequest{
 		Name:    data.Name.ValueString(),
 		VaultID: data.VaultID.ValueString(),
 	})
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create client, got error: %s", err))
+		addClientError(&resp.Diagnostics, "Client Error", fmt.Sprintf("Unable to create client, got error: %s", err), err)
 		return
 	}
 
 	// Map response to model
-	data.ID = types.StringValue(inferaClient.ID.String())
+	data.ID = NewSnowflakeIDValue(inferaClient.ID.String())
 	data.IsActive = types.BoolValue(inferaClient.IsActive)
-	data.CreatedAt = types.StringValue(inferaClient.CreatedAt)
+	data.CreatedAt = NewTimestampValue(inferaClient.CreatedAt)
 
 	// Save data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
@@ -150,22 +168,29 @@ func (r *ClientResource) Read(ctx context.Context, req resource.ReadRequest, res
 		return
 	}
 
+	ctx, cancel, diags := withOperationTimeout(ctx, data.Timeouts, opRead, defaultTimeouts)
+	defer cancel()
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Get the client
-	inferaClient, err := r.client.GetClient(ctx, data.OrganizationID.ValueString(), data.ID.ValueString())
+	inferaClient, err := r.client.LookupClient(ctx, data.OrganizationID.ValueString(), data.ID.ValueString())
 	if err != nil {
-		if apiErr, ok := err.(*client.APIError); ok && apiErr.IsNotFound() {
+		if client.IsNotFound(err) {
 			resp.State.RemoveResource(ctx)
 			return
 		}
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read client, got error: %s", err))
+		addClientError(&resp.Diagnostics, "Client Error", fmt.Sprintf("Unable to read client, got error: %s", err), err)
 		return
 	}
 
 	// Map response to model
-	data.VaultID = types.StringValue(inferaClient.VaultID.String())
+	data.VaultID = NewSnowflakeIDValue(inferaClient.VaultID.String())
 	data.Name = types.StringValue(inferaClient.Name)
 	data.IsActive = types.BoolValue(inferaClient.IsActive)
-	data.CreatedAt = types.StringValue(inferaClient.CreatedAt)
+	data.CreatedAt = NewTimestampValue(inferaClient.CreatedAt)
 
 	// Save updated data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
@@ -181,18 +206,25 @@ func (r *ClientResource) Update(ctx context.Context, req resource.UpdateRequest,
 		return
 	}
 
+	ctx, cancel, diags := withOperationTimeout(ctx, data.Timeouts, opUpdate, defaultTimeouts)
+	defer cancel()
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Update the client
 	inferaClient, err := r.client.UpdateClient(ctx, data.OrganizationID.ValueString(), data.ID.ValueString(), client.UpdateClientRequest{
 		Name:    data.Name.ValueString(),
 		VaultID: data.VaultID.ValueString(),
 	})
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update client, got error: %s", err))
+		addClientError(&resp.Diagnostics, "Client Error", fmt.Sprintf("Unable to update client, got error: %s", err), err)
 		return
 	}
 
 	// Map response to model
-	data.VaultID = types.StringValue(inferaClient.VaultID.String())
+	data.VaultID = NewSnowflakeIDValue(inferaClient.VaultID.String())
 	data.Name = types.StringValue(inferaClient.Name)
 	data.IsActive = types.BoolValue(inferaClient.IsActive)
 
@@ -210,32 +242,33 @@ func (r *ClientResource) Delete(ctx context.Context, req resource.DeleteRequest,
 		return
 	}
 
+	ctx, cancel, diags := withOperationTimeout(ctx, data.Timeouts, opDelete, defaultTimeouts)
+	defer cancel()
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Delete the client
 	err := r.client.DeleteClient(ctx, data.OrganizationID.ValueString(), data.ID.ValueString())
 	if err != nil {
-		if apiErr, ok := err.(*client.APIError); ok && apiErr.IsNotFound() {
+		if client.IsNotFound(err) {
 			// Resource already deleted, no error
 			return
 		}
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete client, got error: %s", err))
+		addClientError(&resp.Diagnostics, "Client Error", fmt.Sprintf("Unable to delete client, got error: %s", err), err)
 		return
 	}
 }
 
 // ImportState imports a client using the format: <org_id>/<client_id>
 func (r *ClientResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	parts := strings.Split(req.ID, "/")
-	if len(parts) != 2 {
-		resp.Diagnostics.AddError(
-			"Invalid Import ID",
-			fmt.Sprintf("Expected import ID in format: <org_id>/<client_id>, got: %s", req.ID),
-		)
+	parts, diags := parseImportID(req.ID, "<org_id>/<client_id>")
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	orgID := parts[0]
-	clientID := parts[1]
-
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("organization_id"), orgID)...)
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), clientID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("organization_id"), parts[0])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), parts[1])...)
 }
@@ -6,13 +6,15 @@ package provider
 import (
 	"context"
 	"fmt"
-	"strings"
 
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/inferadb/terraform-provider-inferadb/internal/client"
 )
@@ -33,16 +35,17 @@ type ClientCertificateResource struct {
 
 // ClientCertificateResourceModel describes the resource data model.
 type ClientCertificateResourceModel struct {
-	ID             types.String `tfsdk:"id"`
-	OrganizationID types.String `tfsdk:"organization_id"`
-	ClientID       types.String `tfsdk:"client_id"`
-	Name           types.String `tfsdk:"name"`
-	KID            types.String `tfsdk:"kid"`
-	PublicKeyPEM   types.String `tfsdk:"public_key_pem"`
-	PrivateKeyPEM  types.String `tfsdk:"private_key_pem"`
-	IsActive       types.Bool   `tfsdk:"is_active"`
-	RevokedAt      types.String `tfsdk:"revoked_at"`
-	CreatedAt      types.String `tfsdk:"created_at"`
+	ID             SnowflakeIDValue `tfsdk:"id"`
+	OrganizationID SnowflakeIDValue `tfsdk:"organization_id"`
+	ClientID       SnowflakeIDValue `tfsdk:"client_id"`
+	Name           types.String     `tfsdk:"name"`
+	KID            types.String     `tfsdk:"kid"`
+	PublicKeyPEM   types.String     `tfsdk:"public_key_pem"`
+	PrivateKeyPEM  types.String     `tfsdk:"private_key_pem"`
+	IsActive       types.Bool       `tfsdk:"is_active"`
+	RevokedAt      TimestampValue   `tfsdk:"revoked_at"`
+	CreatedAt      TimestampValue   `tfsdk:"created_at"`
+	Timeouts       timeouts.Value   `tfsdk:"timeouts"`
 }
 
 // Metadata returns the resource type name.
@@ -80,6 +83,7 @@ output "backend_private_key" {
 
 		Attributes: map[string]schema.Attribute{
 			"id": schema.StringAttribute{
+				CustomType:          SnowflakeIDType{},
 				MarkdownDescription: "Unique Snowflake ID of the certificate.",
 				Computed:            true,
 				PlanModifiers: []planmodifier.String{
@@ -87,6 +91,7 @@ output "backend_private_key" {
 				},
 			},
 			"organization_id": schema.StringAttribute{
+				CustomType:          SnowflakeIDType{},
 				MarkdownDescription: "ID of the organization that owns the client.",
 				Required:            true,
 				PlanModifiers: []planmodifier.String{
@@ -94,6 +99,7 @@ output "backend_private_key" {
 				},
 			},
 			"client_id": schema.StringAttribute{
+				CustomType:          SnowflakeIDType{},
 				MarkdownDescription: "ID of the client this certificate belongs to.",
 				Required:            true,
 				PlanModifiers: []planmodifier.String{
@@ -103,6 +109,7 @@ output "backend_private_key" {
 			"name": schema.StringAttribute{
 				MarkdownDescription: "Human-readable name for the certificate (e.g., 'Production Backend Cert').",
 				Required:            true,
+				Validators:          []validator.String{stringvalidator.LengthAtLeast(1)},
 				PlanModifiers: []planmodifier.String{
 					stringplanmodifier.RequiresReplace(),
 				},
@@ -134,10 +141,12 @@ output "backend_private_key" {
 				Computed:            true,
 			},
 			"revoked_at": schema.StringAttribute{
+				CustomType:          TimestampType{},
 				MarkdownDescription: "ISO 8601 timestamp when the certificate was revoked (null if active).",
 				Computed:            true,
 			},
 			"created_at": schema.StringAttribute{
+				CustomType:          TimestampType{},
 				MarkdownDescription: "ISO 8601 timestamp when the certificate was created.",
 				Computed:            true,
 				PlanModifiers: []planmodifier.String{
@@ -145,6 +154,9 @@ output "backend_private_key" {
 				},
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeoutsBlock(ctx, defaultTimeouts),
+		},
 	}
 }
 
@@ -175,37 +187,42 @@ func (r *ClientCertificateResource) Create(ctx context.Context, req resource.Cre
 		return
 	}
 
+	ctx, cancel, diags := withOperationTimeout(ctx, data.Timeouts, opCreate, defaultTimeouts)
+	defer cancel()
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Create the certificate
 	cert, err := r.client.CreateCertificate(ctx, data.OrganizationID.ValueString(), data.ClientID.ValueString(), client.CreateCertificateRequest{
 		Name: data.Name.ValueString(),
 	})
 	if err != nil {
-		resp.Diagnostics.AddError(
+		addClientError(
+			&resp.Diagnostics,
 			"Error Creating Client Certificate",
 			fmt.Sprintf("Could not create client certificate: %s", err.Error()),
+			err,
 		)
 		return
 	}
 
 	// Map response to model
-	data.ID = types.StringValue(cert.ID)
+	data.ID = NewSnowflakeIDValue(cert.ID)
 	data.KID = types.StringValue(cert.KID)
 	data.PublicKeyPEM = types.StringValue(cert.PublicKeyPEM)
 	data.IsActive = types.BoolValue(cert.IsActive)
-	data.CreatedAt = types.StringValue(cert.CreatedAt)
+	data.CreatedAt = NewTimestampValue(cert.CreatedAt)
 
 	// CRITICAL: Private key is only returned on creation
-	if cert.PrivateKeyPEM != "" {
-		data.PrivateKeyPEM = types.StringValue(cert.PrivateKeyPEM)
-	} else {
-		data.PrivateKeyPEM = types.StringNull()
-	}
+	data.PrivateKeyPEM = types.StringValue(cert.PrivateKeyPEM)
 
 	// Handle optional fields
 	if cert.RevokedAt != nil {
-		data.RevokedAt = types.StringValue(*cert.RevokedAt)
+		data.RevokedAt = NewTimestampValue(*cert.RevokedAt)
 	} else {
-		data.RevokedAt = types.StringNull()
+		data.RevokedAt = NewTimestampNull()
 	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
@@ -220,17 +237,26 @@ func (r *ClientCertificateResource) Read(ctx context.Context, req resource.ReadR
 		return
 	}
 
+	ctx, cancel, diags := withOperationTimeout(ctx, data.Timeouts, opRead, defaultTimeouts)
+	defer cancel()
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Get current certificate state
-	cert, err := r.client.GetCertificate(ctx, data.OrganizationID.ValueString(), data.ClientID.ValueString(), data.ID.ValueString())
+	cert, err := r.client.LookupCertificate(ctx, data.OrganizationID.ValueString(), data.ClientID.ValueString(), data.ID.ValueString())
 	if err != nil {
-		if strings.Contains(err.Error(), "404") || strings.Contains(err.Error(), "not found") {
+		if client.IsNotFound(err) {
 			// Certificate was deleted outside Terraform
 			resp.State.RemoveResource(ctx)
 			return
 		}
-		resp.Diagnostics.AddError(
+		addClientError(
+			&resp.Diagnostics,
 			"Error Reading Client Certificate",
 			fmt.Sprintf("Could not read client certificate %s: %s", data.ID.ValueString(), err.Error()),
+			err,
 		)
 		return
 	}
@@ -239,13 +265,13 @@ func (r *ClientCertificateResource) Read(ctx context.Context, req resource.ReadR
 	data.KID = types.StringValue(cert.KID)
 	data.PublicKeyPEM = types.StringValue(cert.PublicKeyPEM)
 	data.IsActive = types.BoolValue(cert.IsActive)
-	data.CreatedAt = types.StringValue(cert.CreatedAt)
+	data.CreatedAt = NewTimestampValue(cert.CreatedAt)
 
 	// Handle optional fields
 	if cert.RevokedAt != nil {
-		data.RevokedAt = types.StringValue(*cert.RevokedAt)
+		data.RevokedAt = NewTimestampValue(*cert.RevokedAt)
 	} else {
-		data.RevokedAt = types.StringNull()
+		data.RevokedAt = NewTimestampNull()
 	}
 
 	// IMPORTANT: Private key is NOT returned on reads, preserve state value
@@ -254,14 +280,20 @@ func (r *ClientCertificateResource) Read(ctx context.Context, req resource.ReadR
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
-// Update updates the resource (no-op for certificates as all fields require replacement).
+// Update updates the resource (only the timeouts block, as all other fields require replacement).
 func (r *ClientCertificateResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
-	// All certificate attributes require replacement, so this should never be called
-	// This is enforced by RequiresReplace plan modifiers in the schema
-	resp.Diagnostics.AddError(
-		"Update Not Supported",
-		"Client certificates cannot be updated. All changes require replacement.",
-	)
+	var plan, state ClientCertificateResourceModel
+
+	// All certificate attributes require replacement, enforced by RequiresReplace
+	// plan modifiers in the schema, so only the timeouts can change here.
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	state.Timeouts = plan.Timeouts
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
 }
 
 // Delete deletes the resource.
@@ -273,14 +305,23 @@ func (r *ClientCertificateResource) Delete(ctx context.Context, req resource.Del
 		return
 	}
 
+	ctx, cancel, diags := withOperationTimeout(ctx, data.Timeouts, opDelete, defaultTimeouts)
+	defer cancel()
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Delete the certificate
 	err := r.client.DeleteCertificate(ctx, data.OrganizationID.ValueString(), data.ClientID.ValueString(), data.ID.ValueString())
 	if err != nil {
 		// Ignore 404 errors as the resource may have been deleted outside Terraform
-		if !strings.Contains(err.Error(), "404") && !strings.Contains(err.Error(), "not found") {
-			resp.Diagnostics.AddError(
+		if !client.IsNotFound(err) {
+			addClientError(
+				&resp.Diagnostics,
 				"Error Deleting Client Certificate",
 				fmt.Sprintf("Could not delete client certificate %s: %s", data.ID.ValueString(), err.Error()),
+				err,
 			)
 			return
 		}
@@ -289,24 +330,15 @@ func (r *ClientCertificateResource) Delete(ctx context.Context, req resource.Del
 
 // ImportState imports an existing resource into Terraform state.
 func (r *ClientCertificateResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	// Expected format: <org_id>/<client_id>/<cert_id>
-	parts := strings.Split(req.ID, "/")
-	if len(parts) != 3 {
-		resp.Diagnostics.AddError(
-			"Invalid Import ID",
-			fmt.Sprintf("Expected import ID format: <org_id>/<client_id>/<cert_id>, got: %s", req.ID),
-		)
+	parts, diags := parseImportID(req.ID, "<org_id>/<client_id>/<cert_id>")
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	orgID := parts[0]
-	clientID := parts[1]
-	certID := parts[2]
-
-	// Set the IDs in state
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("organization_id"), orgID)...)
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("client_id"), clientID)...)
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), certID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("organization_id"), parts[0])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("client_id"), parts[1])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), parts[2])...)
 
 	// Note: After import, the Read method will be called automatically to populate the rest of the state.
 	// The private_key_pem will be null since it cannot be retrieved after creation.
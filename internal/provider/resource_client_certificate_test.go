@@ -0,0 +1,87 @@
+// Copyright 2025 InferaDB
+// SPDX-License-Identifier: Apache-2.0
+
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+func TestAccClientCertificateResource(t *testing.T) {
+	testCase := testClientCertificateResourceCase()
+	testCase.PreCheck = func() { testAccPreCheck(t) }
+	resource.Test(t, testCase)
+}
+
+func TestClientCertificateResource(t *testing.T) {
+	testFakeControl(t)
+	resource.UnitTest(t, testClientCertificateResourceCase())
+}
+
+func testClientCertificateResourceCase() resource.TestCase {
+	rName := acctest.RandomWithPrefix("tf-test")
+
+	return resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccClientCertificateResourceConfig(rName, "test-cert"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("inferadb_client_certificate.test", "name", "test-cert"),
+					resource.TestCheckResourceAttr("inferadb_client_certificate.test", "is_active", "true"),
+					resource.TestCheckResourceAttrSet("inferadb_client_certificate.test", "id"),
+					resource.TestCheckResourceAttrSet("inferadb_client_certificate.test", "kid"),
+					resource.TestCheckResourceAttrSet("inferadb_client_certificate.test", "public_key_pem"),
+					resource.TestCheckResourceAttrSet("inferadb_client_certificate.test", "private_key_pem"),
+				),
+			},
+			// ImportState testing - the private key is only returned on create
+			{
+				ResourceName:            "inferadb_client_certificate.test",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"private_key_pem"},
+				ImportStateIdFunc: func(s *terraform.State) (string, error) {
+					rs, ok := s.RootModule().Resources["inferadb_client_certificate.test"]
+					if !ok {
+						return "", fmt.Errorf("resource not found: inferadb_client_certificate.test")
+					}
+					return fmt.Sprintf("%s/%s/%s", rs.Primary.Attributes["organization_id"], rs.Primary.Attributes["client_id"], rs.Primary.ID), nil
+				},
+			},
+			// Delete testing is automatic
+		},
+	}
+}
+
+func testAccClientCertificateResourceConfig(orgName, certName string) string {
+	return fmt.Sprintf(`
+resource "inferadb_organization" "test" {
+  name = %[1]q
+  tier = "dev"
+}
+
+resource "inferadb_vault" "test" {
+  organization_id = inferadb_organization.test.id
+  name            = "test-vault"
+}
+
+resource "inferadb_client" "test" {
+  organization_id = inferadb_organization.test.id
+  vault_id        = inferadb_vault.test.id
+  name            = "test-client"
+}
+
+resource "inferadb_client_certificate" "test" {
+  organization_id = inferadb_organization.test.id
+  client_id       = inferadb_client.test.id
+  name            = %[2]q
+}
+`, orgName, certName)
+}
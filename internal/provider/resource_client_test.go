@@ -13,11 +13,21 @@ import (
 )
 
 func TestAccClientResource(t *testing.T) {
+	testCase := testClientResourceCase()
+	testCase.PreCheck = func() { testAccPreCheck(t) }
+	resource.Test(t, testCase)
+}
+
+func TestClientResource(t *testing.T) {
+	testFakeControl(t)
+	resource.UnitTest(t, testClientResourceCase())
+}
+
+func testClientResourceCase() resource.TestCase {
 	rName := acctest.RandomWithPrefix("tf-test")
 	clientName := acctest.RandomWithPrefix("tf-client")
 
-	resource.Test(t, resource.TestCase{
-		PreCheck:                 func() { testAccPreCheck(t) },
+	return resource.TestCase{
 		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
 		Steps: []resource.TestStep{
 			// Create and Read testing
@@ -54,7 +64,7 @@ func TestAccClientResource(t *testing.T) {
 			},
 			// Delete testing is automatic
 		},
-	})
+	}
 }
 
 func testAccClientResourceConfig(orgName, clientName string) string {
@@ -6,14 +6,17 @@ package provider
 import (
 	"context"
 	"fmt"
-	"strings"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/inferadb/terraform-provider-inferadb/internal/client"
 )
@@ -29,13 +32,23 @@ type OrganizationResource struct {
 	client *client.Client
 }
 
+// organizationTimeouts allow for deleting an organization, which removes
+// everything it contains.
+var organizationTimeouts = resourceTimeouts{
+	Create: 2 * time.Minute,
+	Read:   2 * time.Minute,
+	Update: 2 * time.Minute,
+	Delete: 10 * time.Minute,
+}
+
 // OrganizationResourceModel describes the resource data model.
 type OrganizationResourceModel struct {
-	ID          types.String `tfsdk:"id"`
-	Name        types.String `tfsdk:"name"`
-	Tier        types.String `tfsdk:"tier"`
-	CreatedAt   types.String `tfsdk:"created_at"`
-	SuspendedAt types.String `tfsdk:"suspended_at"`
+	ID          SnowflakeIDValue `tfsdk:"id"`
+	Name        types.String     `tfsdk:"name"`
+	Tier        types.String     `tfsdk:"tier"`
+	CreatedAt   TimestampValue   `tfsdk:"created_at"`
+	SuspendedAt TimestampValue   `tfsdk:"suspended_at"`
+	Timeouts    timeouts.Value   `tfsdk:"timeouts"`
 }
 
 // NewOrganizationResource is a helper function to simplify the provider implementation.
@@ -49,7 +62,7 @@ func (r *OrganizationResource) Metadata(_ context.Context, req resource.Metadata
 }
 
 // Schema defines the schema for the resource.
-func (r *OrganizationResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+func (r *OrganizationResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		MarkdownDescription: `Manages an InferaDB organization.
 
@@ -78,6 +91,7 @@ terraform import inferadb_organization.example <org_id>
 ` + "```",
 		Attributes: map[string]schema.Attribute{
 			"id": schema.StringAttribute{
+				CustomType:          SnowflakeIDType{},
 				MarkdownDescription: "Snowflake ID of the organization.",
 				Computed:            true,
 				PlanModifiers: []planmodifier.String{
@@ -87,14 +101,17 @@ terraform import inferadb_organization.example <org_id>
 			"name": schema.StringAttribute{
 				MarkdownDescription: "Name of the organization.",
 				Required:            true,
+				Validators:          []validator.String{stringvalidator.LengthAtLeast(1)},
 			},
 			"tier": schema.StringAttribute{
 				MarkdownDescription: "Tier of the organization. Valid values are `dev`, `pro`, or `max`. Defaults to `dev`.",
 				Optional:            true,
 				Computed:            true,
 				Default:             stringdefault.StaticString("dev"),
+				Validators:          []validator.String{stringvalidator.OneOf(organizationTiers...)},
 			},
 			"created_at": schema.StringAttribute{
+				CustomType:          TimestampType{},
 				MarkdownDescription: "ISO 8601 timestamp when the organization was created.",
 				Computed:            true,
 				PlanModifiers: []planmodifier.String{
@@ -102,10 +119,14 @@ terraform import inferadb_organization.example <org_id>
 				},
 			},
 			"suspended_at": schema.StringAttribute{
+				CustomType:          TimestampType{},
 				MarkdownDescription: "ISO 8601 timestamp when the organization was suspended, if applicable.",
 				Computed:            true,
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeoutsBlock(ctx, organizationTimeouts),
+		},
 	}
 }
 
@@ -137,6 +158,13 @@ func (r *OrganizationResource) Create(ctx context.Context, req resource.CreateRe
 		return
 	}
 
+	ctx, cancel, diags := withOperationTimeout(ctx, plan.Timeouts, opCreate, organizationTimeouts)
+	defer cancel()
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Create the organization via API
 	createReq := client.CreateOrganizationRequest{
 		Name: plan.Name.ValueString(),
@@ -145,26 +173,29 @@ func (r *OrganizationResource) Create(ctx context.Context, req resource.CreateRe
 
 	org, err := r.client.CreateOrganization(ctx, createReq)
 	if err != nil {
-		resp.Diagnostics.AddError(
+		addClientError(
+			&resp.Diagnostics,
 			"Error creating organization",
 			fmt.Sprintf("Could not create organization: %s", err.Error()),
+			err,
 		)
 		return
 	}
 
 	// Map response to resource model
-	plan.ID = types.StringValue(org.ID.String())
+	plan.ID = NewSnowflakeIDValue(org.ID.String())
 	plan.Name = types.StringValue(org.Name)
 	plan.Tier = types.StringValue(org.Tier)
-	plan.CreatedAt = types.StringValue(org.CreatedAt)
+	plan.CreatedAt = NewTimestampValue(org.CreatedAt)
 	if org.SuspendedAt != nil && *org.SuspendedAt != "" {
-		plan.SuspendedAt = types.StringValue(*org.SuspendedAt)
+		plan.SuspendedAt = NewTimestampValue(*org.SuspendedAt)
 	} else {
-		plan.SuspendedAt = types.StringNull()
+		plan.SuspendedAt = NewTimestampNull()
 	}
 
 	// Set state to fully populated data
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	resp.Diagnostics.Append(setETag(ctx, resp.Private, org.ETag)...)
 }
 
 // Read refreshes the Terraform state with the latest data.
@@ -177,18 +208,27 @@ func (r *OrganizationResource) Read(ctx context.Context, req resource.ReadReques
 		return
 	}
 
+	ctx, cancel, diags := withOperationTimeout(ctx, state.Timeouts, opRead, organizationTimeouts)
+	defer cancel()
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Get organization from API
 	org, err := r.client.GetOrganization(ctx, state.ID.ValueString())
 	if err != nil {
 		// Handle 404 - resource was deleted outside Terraform
-		if apiErr, ok := err.(*client.APIError); ok && apiErr.IsNotFound() {
+		if client.IsNotFound(err) {
 			resp.State.RemoveResource(ctx)
 			return
 		}
 
-		resp.Diagnostics.AddError(
+		addClientError(
+			&resp.Diagnostics,
 			"Error reading organization",
 			fmt.Sprintf("Could not read organization %s: %s", state.ID.ValueString(), err.Error()),
+			err,
 		)
 		return
 	}
@@ -196,15 +236,16 @@ func (r *OrganizationResource) Read(ctx context.Context, req resource.ReadReques
 	// Update state with refreshed data
 	state.Name = types.StringValue(org.Name)
 	state.Tier = types.StringValue(org.Tier)
-	state.CreatedAt = types.StringValue(org.CreatedAt)
+	state.CreatedAt = NewTimestampValue(org.CreatedAt)
 	if org.SuspendedAt != nil && *org.SuspendedAt != "" {
-		state.SuspendedAt = types.StringValue(*org.SuspendedAt)
+		state.SuspendedAt = NewTimestampValue(*org.SuspendedAt)
 	} else {
-		state.SuspendedAt = types.StringNull()
+		state.SuspendedAt = NewTimestampNull()
 	}
 
 	// Set refreshed state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	resp.Diagnostics.Append(setETag(ctx, resp.Private, org.ETag)...)
 }
 
 // Update updates the resource and sets the updated Terraform state on success.
@@ -219,34 +260,52 @@ func (r *OrganizationResource) Update(ctx context.Context, req resource.UpdateRe
 		return
 	}
 
+	ctx, cancel, diags := withOperationTimeout(ctx, plan.Timeouts, opUpdate, organizationTimeouts)
+	defer cancel()
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	etag, diags := getETag(ctx, req.Private)
+	resp.Diagnostics.Append(diags...)
+
 	// Update organization via API
 	updateReq := client.UpdateOrganizationRequest{
-		Name: plan.Name.ValueString(),
-		Tier: plan.Tier.ValueString(),
+		Name:    plan.Name.ValueString(),
+		Tier:    plan.Tier.ValueString(),
+		IfMatch: etag,
 	}
 
 	org, err := r.client.UpdateOrganization(ctx, state.ID.ValueString(), updateReq)
 	if err != nil {
-		resp.Diagnostics.AddError(
+		if client.IsPreconditionFailed(err) {
+			addChangedOutsideTerraformError(&resp.Diagnostics, "Organization", state.ID.ValueString())
+			return
+		}
+		addClientError(
+			&resp.Diagnostics,
 			"Error updating organization",
 			fmt.Sprintf("Could not update organization %s: %s", state.ID.ValueString(), err.Error()),
+			err,
 		)
 		return
 	}
 
 	// Update state with response data
-	plan.ID = types.StringValue(org.ID.String())
+	plan.ID = NewSnowflakeIDValue(org.ID.String())
 	plan.Name = types.StringValue(org.Name)
 	plan.Tier = types.StringValue(org.Tier)
-	plan.CreatedAt = types.StringValue(org.CreatedAt)
+	plan.CreatedAt = NewTimestampValue(org.CreatedAt)
 	if org.SuspendedAt != nil && *org.SuspendedAt != "" {
-		plan.SuspendedAt = types.StringValue(*org.SuspendedAt)
+		plan.SuspendedAt = NewTimestampValue(*org.SuspendedAt)
 	} else {
-		plan.SuspendedAt = types.StringNull()
+		plan.SuspendedAt = NewTimestampNull()
 	}
 
 	// Set state to fully populated data
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	resp.Diagnostics.Append(setETag(ctx, resp.Private, org.ETag)...)
 }
 
 // Delete deletes the resource and removes the Terraform state on success.
@@ -259,17 +318,26 @@ func (r *OrganizationResource) Delete(ctx context.Context, req resource.DeleteRe
 		return
 	}
 
+	ctx, cancel, diags := withOperationTimeout(ctx, state.Timeouts, opDelete, organizationTimeouts)
+	defer cancel()
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Delete organization via API
 	err := r.client.DeleteOrganization(ctx, state.ID.ValueString())
 	if err != nil {
 		// Handle 404 - resource was already deleted
-		if apiErr, ok := err.(*client.APIError); ok && apiErr.IsNotFound() {
+		if client.IsNotFound(err) {
 			return
 		}
 
-		resp.Diagnostics.AddError(
+		addClientError(
+			&resp.Diagnostics,
 			"Error deleting organization",
 			fmt.Sprintf("Could not delete organization %s: %s", state.ID.ValueString(), err.Error()),
+			err,
 		)
 		return
 	}
@@ -277,16 +345,11 @@ func (r *OrganizationResource) Delete(ctx context.Context, req resource.DeleteRe
 
 // ImportState imports the resource into Terraform state.
 func (r *OrganizationResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	// Import format: <org_id>
-	id := strings.TrimSpace(req.ID)
-	if id == "" {
-		resp.Diagnostics.AddError(
-			"Invalid Import ID",
-			"Organization ID cannot be empty. Use format: <org_id>",
-		)
+	parts, diags := parseImportID(req.ID, "<org_id>")
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	// Set the ID and trigger a Read to populate the rest
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), id)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), parts[0])...)
 }
@@ -12,10 +12,20 @@ import (
 )
 
 func TestAccOrganizationResource(t *testing.T) {
+	testCase := testOrganizationResourceCase()
+	testCase.PreCheck = func() { testAccPreCheck(t) }
+	resource.Test(t, testCase)
+}
+
+func TestOrganizationResource(t *testing.T) {
+	testFakeControl(t)
+	resource.UnitTest(t, testOrganizationResourceCase())
+}
+
+func testOrganizationResourceCase() resource.TestCase {
 	rName := acctest.RandomWithPrefix("tf-test")
 
-	resource.Test(t, resource.TestCase{
-		PreCheck:                 func() { testAccPreCheck(t) },
+	return resource.TestCase{
 		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
 		Steps: []resource.TestStep{
 			// Create and Read testing
@@ -44,7 +54,7 @@ func TestAccOrganizationResource(t *testing.T) {
 			},
 			// Delete testing is automatic
 		},
-	})
+	}
 }
 
 func testAccOrganizationResourceConfig(name, tier string) string {
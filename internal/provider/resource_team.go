@@ -6,13 +6,15 @@ package provider
 import (
 	"context"
 	"fmt"
-	"strings"
 
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/inferadb/terraform-provider-inferadb/internal/client"
 )
@@ -30,11 +32,12 @@ type TeamResource struct {
 
 // TeamResourceModel describes the resource data model.
 type TeamResourceModel struct {
-	ID             types.String `tfsdk:"id"`
-	OrganizationID types.String `tfsdk:"organization_id"`
-	Name           types.String `tfsdk:"name"`
-	Description    types.String `tfsdk:"description"`
-	CreatedAt      types.String `tfsdk:"created_at"`
+	ID             SnowflakeIDValue `tfsdk:"id"`
+	OrganizationID SnowflakeIDValue `tfsdk:"organization_id"`
+	Name           types.String     `tfsdk:"name"`
+	Description    types.String     `tfsdk:"description"`
+	CreatedAt      TimestampValue   `tfsdk:"created_at"`
+	Timeouts       timeouts.Value   `tfsdk:"timeouts"`
 }
 
 // NewTeamResource is a helper function to simplify the provider implementation.
@@ -48,7 +51,7 @@ func (r *TeamResource) Metadata(_ context.Context, req resource.MetadataRequest,
 }
 
 // Schema defines the schema for the resource.
-func (r *TeamResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+func (r *TeamResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		MarkdownDescription: `Manages an InferaDB team.
 
@@ -83,6 +86,7 @@ terraform import inferadb_team.engineering <org_id>/<team_id>
 ` + "```",
 		Attributes: map[string]schema.Attribute{
 			"id": schema.StringAttribute{
+				CustomType:          SnowflakeIDType{},
 				MarkdownDescription: "Snowflake ID of the team.",
 				Computed:            true,
 				PlanModifiers: []planmodifier.String{
@@ -90,6 +94,7 @@ terraform import inferadb_team.engineering <org_id>/<team_id>
 				},
 			},
 			"organization_id": schema.StringAttribute{
+				CustomType:          SnowflakeIDType{},
 				MarkdownDescription: "ID of the organization this team belongs to.",
 				Required:            true,
 				PlanModifiers: []planmodifier.String{
@@ -99,12 +104,14 @@ terraform import inferadb_team.engineering <org_id>/<team_id>
 			"name": schema.StringAttribute{
 				MarkdownDescription: "Name of the team.",
 				Required:            true,
+				Validators:          []validator.String{stringvalidator.LengthAtLeast(1)},
 			},
 			"description": schema.StringAttribute{
 				MarkdownDescription: "Description of the team.",
 				Optional:            true,
 			},
 			"created_at": schema.StringAttribute{
+				CustomType:          TimestampType{},
 				MarkdownDescription: "ISO 8601 timestamp when the team was created.",
 				Computed:            true,
 				PlanModifiers: []planmodifier.String{
@@ -112,6 +119,9 @@ terraform import inferadb_team.engineering <org_id>/<team_id>
 				},
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeoutsBlock(ctx, defaultTimeouts),
+		},
 	}
 }
 
@@ -143,6 +153,13 @@ func (r *TeamResource) Create(ctx context.Context, req resource.CreateRequest, r
 		return
 	}
 
+	ctx, cancel, diags := withOperationTimeout(ctx, plan.Timeouts, opCreate, defaultTimeouts)
+	defer cancel()
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Create the team via API
 	createReq := client.CreateTeamRequest{
 		Name:        plan.Name.ValueString(),
@@ -151,26 +168,29 @@ func (r *TeamResource) Create(ctx context.Context, req resource.CreateRequest, r
 
 	team, err := r.client.CreateTeam(ctx, plan.OrganizationID.ValueString(), createReq)
 	if err != nil {
-		resp.Diagnostics.AddError(
+		addClientError(
+			&resp.Diagnostics,
 			"Error creating team",
 			fmt.Sprintf("Could not create team: %s", err.Error()),
+			err,
 		)
 		return
 	}
 
 	// Map response to resource model
-	plan.ID = types.StringValue(team.ID.String())
-	plan.OrganizationID = types.StringValue(team.OrganizationID.String())
+	plan.ID = NewSnowflakeIDValue(team.ID.String())
+	plan.OrganizationID = NewSnowflakeIDValue(team.OrganizationID.String())
 	plan.Name = types.StringValue(team.Name)
 	if team.Description != "" {
 		plan.Description = types.StringValue(team.Description)
 	} else {
 		plan.Description = types.StringNull()
 	}
-	plan.CreatedAt = types.StringValue(team.CreatedAt)
+	plan.CreatedAt = NewTimestampValue(team.CreatedAt)
 
 	// Set state to fully populated data
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	resp.Diagnostics.Append(setETag(ctx, resp.Private, team.ETag)...)
 }
 
 // Read refreshes the Terraform state with the latest data.
@@ -183,34 +203,44 @@ func (r *TeamResource) Read(ctx context.Context, req resource.ReadRequest, resp
 		return
 	}
 
+	ctx, cancel, diags := withOperationTimeout(ctx, state.Timeouts, opRead, defaultTimeouts)
+	defer cancel()
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Get team from API
 	team, err := r.client.GetTeam(ctx, state.OrganizationID.ValueString(), state.ID.ValueString())
 	if err != nil {
 		// Handle 404 - resource was deleted outside Terraform
-		if apiErr, ok := err.(*client.APIError); ok && apiErr.IsNotFound() {
+		if client.IsNotFound(err) {
 			resp.State.RemoveResource(ctx)
 			return
 		}
 
-		resp.Diagnostics.AddError(
+		addClientError(
+			&resp.Diagnostics,
 			"Error reading team",
 			fmt.Sprintf("Could not read team %s: %s", state.ID.ValueString(), err.Error()),
+			err,
 		)
 		return
 	}
 
 	// Update state with refreshed data
-	state.OrganizationID = types.StringValue(team.OrganizationID.String())
+	state.OrganizationID = NewSnowflakeIDValue(team.OrganizationID.String())
 	state.Name = types.StringValue(team.Name)
 	if team.Description != "" {
 		state.Description = types.StringValue(team.Description)
 	} else {
 		state.Description = types.StringNull()
 	}
-	state.CreatedAt = types.StringValue(team.CreatedAt)
+	state.CreatedAt = NewTimestampValue(team.CreatedAt)
 
 	// Set refreshed state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	resp.Diagnostics.Append(setETag(ctx, resp.Private, team.ETag)...)
 }
 
 // Update updates the resource and sets the updated Terraform state on success.
@@ -225,34 +255,52 @@ func (r *TeamResource) Update(ctx context.Context, req resource.UpdateRequest, r
 		return
 	}
 
+	ctx, cancel, diags := withOperationTimeout(ctx, plan.Timeouts, opUpdate, defaultTimeouts)
+	defer cancel()
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	etag, diags := getETag(ctx, req.Private)
+	resp.Diagnostics.Append(diags...)
+
 	// Update team via API
 	updateReq := client.UpdateTeamRequest{
 		Name:        plan.Name.ValueString(),
 		Description: plan.Description.ValueString(),
+		IfMatch:     etag,
 	}
 
 	team, err := r.client.UpdateTeam(ctx, state.OrganizationID.ValueString(), state.ID.ValueString(), updateReq)
 	if err != nil {
-		resp.Diagnostics.AddError(
+		if client.IsPreconditionFailed(err) {
+			addChangedOutsideTerraformError(&resp.Diagnostics, "Team", state.ID.ValueString())
+			return
+		}
+		addClientError(
+			&resp.Diagnostics,
 			"Error updating team",
 			fmt.Sprintf("Could not update team %s: %s", state.ID.ValueString(), err.Error()),
+			err,
 		)
 		return
 	}
 
 	// Update state with response data
-	plan.ID = types.StringValue(team.ID.String())
-	plan.OrganizationID = types.StringValue(team.OrganizationID.String())
+	plan.ID = NewSnowflakeIDValue(team.ID.String())
+	plan.OrganizationID = NewSnowflakeIDValue(team.OrganizationID.String())
 	plan.Name = types.StringValue(team.Name)
 	if team.Description != "" {
 		plan.Description = types.StringValue(team.Description)
 	} else {
 		plan.Description = types.StringNull()
 	}
-	plan.CreatedAt = types.StringValue(team.CreatedAt)
+	plan.CreatedAt = NewTimestampValue(team.CreatedAt)
 
 	// Set state to fully populated data
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	resp.Diagnostics.Append(setETag(ctx, resp.Private, team.ETag)...)
 }
 
 // Delete deletes the resource and removes the Terraform state on success.
@@ -265,17 +313,26 @@ func (r *TeamResource) Delete(ctx context.Context, req resource.DeleteRequest, r
 		return
 	}
 
+	ctx, cancel, diags := withOperationTimeout(ctx, state.Timeouts, opDelete, defaultTimeouts)
+	defer cancel()
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Delete team via API
 	err := r.client.DeleteTeam(ctx, state.OrganizationID.ValueString(), state.ID.ValueString())
 	if err != nil {
 		// Handle 404 - resource was already deleted
-		if apiErr, ok := err.(*client.APIError); ok && apiErr.IsNotFound() {
+		if client.IsNotFound(err) {
 			return
 		}
 
-		resp.Diagnostics.AddError(
+		addClientError(
+			&resp.Diagnostics,
 			"Error deleting team",
 			fmt.Sprintf("Could not delete team %s: %s", state.ID.ValueString(), err.Error()),
+			err,
 		)
 		return
 	}
@@ -283,20 +340,12 @@ func (r *TeamResource) Delete(ctx context.Context, req resource.DeleteRequest, r
 
 // ImportState imports the resource into Terraform state.
 func (r *TeamResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	// Import format: <org_id>/<team_id>
-	parts := strings.Split(req.ID, "/")
-	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
-		resp.Diagnostics.AddError(
-			"Invalid Import ID",
-			"Team import ID must be in the format: <org_id>/<team_id>",
-		)
+	parts, diags := parseImportID(req.ID, "<org_id>/<team_id>")
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	orgID := strings.TrimSpace(parts[0])
-	teamID := strings.TrimSpace(parts[1])
-
-	// Set the attributes and trigger a Read to populate the rest
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("organization_id"), orgID)...)
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), teamID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("organization_id"), parts[0])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), parts[1])...)
 }
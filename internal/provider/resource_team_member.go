@@ -6,13 +6,15 @@ package provider
 import (
 	"context"
 	"fmt"
-	"strings"
 
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/inferadb/terraform-provider-inferadb/internal/client"
 )
@@ -33,12 +35,13 @@ type TeamMemberResource struct {
 
 // TeamMemberResourceModel describes the resource data model.
 type TeamMemberResourceModel struct {
-	ID             types.String `tfsdk:"id"`
-	OrganizationID types.String `tfsdk:"organization_id"`
-	TeamID         types.String `tfsdk:"team_id"`
-	UserID         types.String `tfsdk:"user_id"`
-	Role           types.String `tfsdk:"role"`
-	CreatedAt      types.String `tfsdk:"created_at"`
+	ID             SnowflakeIDValue `tfsdk:"id"`
+	OrganizationID SnowflakeIDValue `tfsdk:"organization_id"`
+	TeamID         SnowflakeIDValue `tfsdk:"team_id"`
+	UserID         SnowflakeIDValue `tfsdk:"user_id"`
+	Role           types.String     `tfsdk:"role"`
+	CreatedAt      TimestampValue   `tfsdk:"created_at"`
+	Timeouts       timeouts.Value   `tfsdk:"timeouts"`
 }
 
 // Metadata sets the resource type name.
@@ -74,11 +77,12 @@ resource "inferadb_team_member" "maintainer" {
 Team members can be imported using the format: ` + "`<org_id>/<team_id>/<member_id>`" + `
 
 ` + "```shell" + `
-terraform import inferadb_team_member.example org_123/team_456/member_789
+terraform import inferadb_team_member.example 123456789/234567890/345678901
 ` + "```",
 
 		Attributes: map[string]schema.Attribute{
 			"id": schema.StringAttribute{
+				CustomType:          SnowflakeIDType{},
 				MarkdownDescription: "Membership ID",
 				Computed:            true,
 				PlanModifiers: []planmodifier.String{
@@ -86,6 +90,7 @@ terraform import inferadb_team_member.example org_123/team_456/member_789
 				},
 			},
 			"organization_id": schema.StringAttribute{
+				CustomType:          SnowflakeIDType{},
 				MarkdownDescription: "Parent organization ID",
 				Required:            true,
 				PlanModifiers: []planmodifier.String{
@@ -93,6 +98,7 @@ terraform import inferadb_team_member.example org_123/team_456/member_789
 				},
 			},
 			"team_id": schema.StringAttribute{
+				CustomType:          SnowflakeIDType{},
 				MarkdownDescription: "Parent team ID",
 				Required:            true,
 				PlanModifiers: []planmodifier.String{
@@ -100,6 +106,7 @@ terraform import inferadb_team_member.example org_123/team_456/member_789
 				},
 			},
 			"user_id": schema.StringAttribute{
+				CustomType:          SnowflakeIDType{},
 				MarkdownDescription: "User ID to add",
 				Required:            true,
 				PlanModifiers: []planmodifier.String{
@@ -109,8 +116,10 @@ terraform import inferadb_team_member.example org_123/team_456/member_789
 			"role": schema.StringAttribute{
 				MarkdownDescription: "Role: maintainer, member",
 				Required:            true,
+				Validators:          []validator.String{stringvalidator.OneOf(teamMemberRoles...)},
 			},
 			"created_at": schema.StringAttribute{
+				CustomType:          TimestampType{},
 				MarkdownDescription: "ISO 8601 timestamp when membership was created",
 				Computed:            true,
 				PlanModifiers: []planmodifier.String{
@@ -118,6 +127,9 @@ terraform import inferadb_team_member.example org_123/team_456/member_789
 				},
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeoutsBlock(ctx, defaultTimeouts),
+		},
 	}
 }
 
@@ -149,6 +161,13 @@ func (r *TeamMemberResource) Create(ctx context.Context, req resource.CreateRequ
 		return
 	}
 
+	ctx, cancel, diags := withOperationTimeout(ctx, data.Timeouts, opCreate, defaultTimeouts)
+	defer cancel()
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Create the team member
 	member, err := r.client.AddTeamMember(
 		ctx,
@@ -160,19 +179,22 @@ func (r *TeamMemberResource) Create(ctx context.Context, req resource.CreateRequ
 		},
 	)
 	if err != nil {
-		resp.Diagnostics.AddError(
+		addClientError(
+			&resp.Diagnostics,
 			"Error creating team member",
 			fmt.Sprintf("Could not add team member: %s", err.Error()),
+			err,
 		)
 		return
 	}
 
 	// Map response to model
-	data.ID = types.StringValue(member.ID)
-	data.CreatedAt = types.StringValue(member.CreatedAt)
+	data.ID = NewSnowflakeIDValue(member.ID)
+	data.CreatedAt = NewTimestampValue(member.CreatedAt)
 
 	// Save data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(setETag(ctx, resp.Private, member.ETag)...)
 }
 
 // Read reads the current team member state.
@@ -185,34 +207,49 @@ func (r *TeamMemberResource) Read(ctx context.Context, req resource.ReadRequest,
 		return
 	}
 
+	ctx, cancel, diags := withOperationTimeout(ctx, data.Timeouts, opRead, defaultTimeouts)
+	defer cancel()
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Get the team member
-	member, err := r.client.GetTeamMember(
+	member, err := r.client.LookupTeamMember(
 		ctx,
 		data.OrganizationID.ValueString(),
 		data.TeamID.ValueString(),
 		data.ID.ValueString(),
 	)
+	if err == nil && member.ETag == "" {
+		// Listed items carry no ETag. Fetch the one of the current version,
+		// so the next update applies only to what this refresh saw.
+		member, err = r.client.GetTeamMember(ctx, data.OrganizationID.ValueString(), data.TeamID.ValueString(), data.ID.ValueString())
+	}
 	if err != nil {
 		// Handle 404 - resource was deleted outside Terraform
-		if apiErr, ok := err.(*client.APIError); ok && apiErr.IsNotFound() {
+		if client.IsNotFound(err) {
 			resp.State.RemoveResource(ctx)
 			return
 		}
 
-		resp.Diagnostics.AddError(
+		addClientError(
+			&resp.Diagnostics,
 			"Error reading team member",
 			fmt.Sprintf("Could not read team member %s: %s", data.ID.ValueString(), err.Error()),
+			err,
 		)
 		return
 	}
 
 	// Map response to model
-	data.UserID = types.StringValue(member.UserID)
+	data.UserID = NewSnowflakeIDValue(member.UserID)
 	data.Role = types.StringValue(member.Role)
-	data.CreatedAt = types.StringValue(member.CreatedAt)
+	data.CreatedAt = NewTimestampValue(member.CreatedAt)
 
 	// Save updated data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(setETag(ctx, resp.Private, member.ETag)...)
 }
 
 // Update updates an existing team member.
@@ -225,6 +262,16 @@ func (r *TeamMemberResource) Update(ctx context.Context, req resource.UpdateRequ
 		return
 	}
 
+	ctx, cancel, diags := withOperationTimeout(ctx, data.Timeouts, opUpdate, defaultTimeouts)
+	defer cancel()
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	etag, diags := getETag(ctx, req.Private)
+	resp.Diagnostics.Append(diags...)
+
 	// Update the team member
 	member, err := r.client.UpdateTeamMember(
 		ctx,
@@ -232,13 +279,20 @@ func (r *TeamMemberResource) Update(ctx context.Context, req resource.UpdateRequ
 		data.TeamID.ValueString(),
 		data.ID.ValueString(),
 		client.UpdateTeamMemberRequest{
-			Role: data.Role.ValueString(),
+			Role:    data.Role.ValueString(),
+			IfMatch: etag,
 		},
 	)
 	if err != nil {
-		resp.Diagnostics.AddError(
+		if client.IsPreconditionFailed(err) {
+			addChangedOutsideTerraformError(&resp.Diagnostics, "Team Member", data.ID.ValueString())
+			return
+		}
+		addClientError(
+			&resp.Diagnostics,
 			"Error updating team member",
 			fmt.Sprintf("Could not update team member: %s", err.Error()),
+			err,
 		)
 		return
 	}
@@ -248,6 +302,7 @@ func (r *TeamMemberResource) Update(ctx context.Context, req resource.UpdateRequ
 
 	// Save updated data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(setETag(ctx, resp.Private, member.ETag)...)
 }
 
 // Delete deletes a team member.
@@ -260,6 +315,13 @@ func (r *TeamMemberResource) Delete(ctx context.Context, req resource.DeleteRequ
 		return
 	}
 
+	ctx, cancel, diags := withOperationTimeout(ctx, data.Timeouts, opDelete, defaultTimeouts)
+	defer cancel()
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Delete the team member
 	err := r.client.RemoveTeamMember(
 		ctx,
@@ -269,13 +331,15 @@ func (r *TeamMemberResource) Delete(ctx context.Context, req resource.DeleteRequ
 	)
 	if err != nil {
 		// If resource is already gone, that's okay
-		if apiErr, ok := err.(*client.APIError); ok && apiErr.IsNotFound() {
+		if client.IsNotFound(err) {
 			return
 		}
 
-		resp.Diagnostics.AddError(
+		addClientError(
+			&resp.Diagnostics,
 			"Error deleting team member",
 			fmt.Sprintf("Could not remove team member: %s", err.Error()),
+			err,
 		)
 		return
 	}
@@ -283,20 +347,13 @@ func (r *TeamMemberResource) Delete(ctx context.Context, req resource.DeleteRequ
 
 // ImportState imports a team member using the format: <org_id>/<team_id>/<member_id>
 func (r *TeamMemberResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	parts := strings.Split(req.ID, "/")
-	if len(parts) != 3 {
-		resp.Diagnostics.AddError(
-			"Invalid Import ID",
-			fmt.Sprintf("Expected import ID in format: <org_id>/<team_id>/<member_id>, got: %s", req.ID),
-		)
+	parts, diags := parseImportID(req.ID, "<org_id>/<team_id>/<member_id>")
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	orgID := parts[0]
-	teamID := parts[1]
-	memberID := parts[2]
-
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("organization_id"), orgID)...)
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("team_id"), teamID)...)
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), memberID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("organization_id"), parts[0])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("team_id"), parts[1])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), parts[2])...)
 }
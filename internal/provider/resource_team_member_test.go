@@ -0,0 +1,79 @@
+// Copyright 2025 InferaDB
+// SPDX-License-Identifier: Apache-2.0
+
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+// Team members need an existing user, so this test only runs against the
+// fake Control API, where users can be created out of band.
+func TestTeamMemberResource(t *testing.T) {
+	srv := testFakeControl(t)
+	userID := srv.AddUser("member@example.com")
+	rName := acctest.RandomWithPrefix("tf-test")
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccTeamMemberResourceConfig(rName, userID, "member"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("inferadb_team_member.test", "user_id", userID),
+					resource.TestCheckResourceAttr("inferadb_team_member.test", "role", "member"),
+					resource.TestCheckResourceAttrSet("inferadb_team_member.test", "id"),
+					resource.TestCheckResourceAttrSet("inferadb_team_member.test", "created_at"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "inferadb_team_member.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: func(s *terraform.State) (string, error) {
+					rs, ok := s.RootModule().Resources["inferadb_team_member.test"]
+					if !ok {
+						return "", fmt.Errorf("resource not found: inferadb_team_member.test")
+					}
+					return fmt.Sprintf("%s/%s/%s", rs.Primary.Attributes["organization_id"], rs.Primary.Attributes["team_id"], rs.Primary.ID), nil
+				},
+			},
+			// Update testing - change role
+			{
+				Config: testAccTeamMemberResourceConfig(rName, userID, "maintainer"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("inferadb_team_member.test", "role", "maintainer"),
+				),
+			},
+			// Delete testing is automatic
+		},
+	})
+}
+
+func testAccTeamMemberResourceConfig(orgName, userID, role string) string {
+	return fmt.Sprintf(`
+resource "inferadb_organization" "test" {
+  name = %[1]q
+  tier = "dev"
+}
+
+resource "inferadb_team" "test" {
+  organization_id = inferadb_organization.test.id
+  name            = "test-team"
+}
+
+resource "inferadb_team_member" "test" {
+  organization_id = inferadb_organization.test.id
+  team_id         = inferadb_team.test.id
+  user_id         = %[2]q
+  role            = %[3]q
+}
+`, orgName, userID, role)
+}
@@ -13,11 +13,21 @@ import (
 )
 
 func TestAccTeamResource(t *testing.T) {
+	testCase := testTeamResourceCase()
+	testCase.PreCheck = func() { testAccPreCheck(t) }
+	resource.Test(t, testCase)
+}
+
+func TestTeamResource(t *testing.T) {
+	testFakeControl(t)
+	resource.UnitTest(t, testTeamResourceCase())
+}
+
+func testTeamResourceCase() resource.TestCase {
 	rName := acctest.RandomWithPrefix("tf-test")
 	teamName := acctest.RandomWithPrefix("tf-team")
 
-	resource.Test(t, resource.TestCase{
-		PreCheck:                 func() { testAccPreCheck(t) },
+	return resource.TestCase{
 		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
 		Steps: []resource.TestStep{
 			// Create and Read testing
@@ -54,7 +64,7 @@ func TestAccTeamResource(t *testing.T) {
 			},
 			// Delete testing is automatic
 		},
-	})
+	}
 }
 
 func testAccTeamResourceConfig(orgName, teamName, description string) string {
@@ -6,13 +6,16 @@ package provider
 import (
 	"context"
 	"fmt"
-	"strings"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/inferadb/terraform-provider-inferadb/internal/client"
 )
@@ -33,14 +36,27 @@ type VaultResource struct {
 	client *client.Client
 }
 
+// vaultTimeouts allow for the engine sync that follows vault creation.
+var vaultTimeouts = resourceTimeouts{
+	Create: 10 * time.Minute,
+	Read:   2 * time.Minute,
+	Update: 2 * time.Minute,
+	Delete: 5 * time.Minute,
+}
+
+// vaultSyncPollInterval is how often a new vault is polled while its sync
+// status is pending.
+var vaultSyncPollInterval = 2 * time.Second
+
 // VaultResourceModel describes the resource data model.
 type VaultResourceModel struct {
-	ID             types.String `tfsdk:"id"`
-	OrganizationID types.String `tfsdk:"organization_id"`
-	Name           types.String `tfsdk:"name"`
-	Description    types.String `tfsdk:"description"`
-	SyncStatus     types.String `tfsdk:"sync_status"`
-	CreatedAt      types.String `tfsdk:"created_at"`
+	ID             SnowflakeIDValue `tfsdk:"id"`
+	OrganizationID SnowflakeIDValue `tfsdk:"organization_id"`
+	Name           types.String     `tfsdk:"name"`
+	Description    types.String     `tfsdk:"description"`
+	SyncStatus     types.String     `tfsdk:"sync_status"`
+	CreatedAt      TimestampValue   `tfsdk:"created_at"`
+	Timeouts       timeouts.Value   `tfsdk:"timeouts"`
 }
 
 // Metadata returns the resource type name.
@@ -75,6 +91,7 @@ terraform import inferadb_vault.production 123456789/987654321
 ` + "```",
 		Attributes: map[string]schema.Attribute{
 			"id": schema.StringAttribute{
+				CustomType:          SnowflakeIDType{},
 				MarkdownDescription: "Snowflake ID of the vault.",
 				Computed:            true,
 				PlanModifiers: []planmodifier.String{
@@ -82,6 +99,7 @@ terraform import inferadb_vault.production 123456789/987654321
 				},
 			},
 			"organization_id": schema.StringAttribute{
+				CustomType:          SnowflakeIDType{},
 				MarkdownDescription: "ID of the parent organization.",
 				Required:            true,
 				PlanModifiers: []planmodifier.String{
@@ -91,6 +109,7 @@ terraform import inferadb_vault.production 123456789/987654321
 			"name": schema.StringAttribute{
 				MarkdownDescription: "Name of the vault.",
 				Required:            true,
+				Validators:          []validator.String{stringvalidator.LengthAtLeast(1)},
 			},
 			"description": schema.StringAttribute{
 				MarkdownDescription: "Description of the vault.",
@@ -101,6 +120,7 @@ terraform import inferadb_vault.production 123456789/987654321
 				Computed:            true,
 			},
 			"created_at": schema.StringAttribute{
+				CustomType:          TimestampType{},
 				MarkdownDescription: "ISO 8601 timestamp of when the vault was created.",
 				Computed:            true,
 				PlanModifiers: []planmodifier.String{
@@ -108,6 +128,9 @@ terraform import inferadb_vault.production 123456789/987654321
 				},
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeoutsBlock(ctx, vaultTimeouts),
+		},
 	}
 }
 
@@ -140,6 +163,13 @@ func (r *VaultResource) Create(ctx context.Context, req resource.CreateRequest,
 		return
 	}
 
+	ctx, cancel, diags := withOperationTimeout(ctx, plan.Timeouts, opCreate, vaultTimeouts)
+	defer cancel()
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Create the vault via the API
 	createReq := client.CreateVaultRequest{
 		Name:        plan.Name.ValueString(),
@@ -148,16 +178,45 @@ func (r *VaultResource) Create(ctx context.Context, req resource.CreateRequest,
 
 	vault, err := r.client.CreateVault(ctx, plan.OrganizationID.ValueString(), createReq)
 	if err != nil {
-		resp.Diagnostics.AddError(
+		addClientError(
+			&resp.Diagnostics,
 			"Error Creating Vault",
 			fmt.Sprintf("Could not create vault: %s", err.Error()),
+			err,
 		)
 		return
 	}
 
+	// Wait for the engine to sync the new vault. The vault exists even when
+	// waiting fails, so it is still saved and the error marks it tainted.
+	if vault.SyncStatus == client.VaultSyncPending {
+		synced, waitErr := r.client.WaitForVaultSync(ctx, vault.OrganizationID.String(), vault.ID.String(), vaultSyncPollInterval)
+		if synced != nil {
+			vault = synced
+		}
+		if waitErr != nil {
+			addClientError(
+				&resp.Diagnostics,
+				"Error Waiting for Vault Sync",
+				fmt.Sprintf("Vault %s was created but did not finish syncing: %s. Increase the create timeout if the engine needs more time.", vault.ID, waitErr.Error()),
+				waitErr,
+			)
+		}
+	}
+	if vault.SyncStatus == client.VaultSyncFailed {
+		syncError := "no error reported"
+		if vault.SyncError != nil && *vault.SyncError != "" {
+			syncError = *vault.SyncError
+		}
+		resp.Diagnostics.AddError(
+			"Vault Sync Failed",
+			fmt.Sprintf("Vault %s was created but the engine failed to sync it: %s", vault.ID, syncError),
+		)
+	}
+
 	// Map response to model
-	plan.ID = types.StringValue(vault.ID.String())
-	plan.OrganizationID = types.StringValue(vault.OrganizationID.String())
+	plan.ID = NewSnowflakeIDValue(vault.ID.String())
+	plan.OrganizationID = NewSnowflakeIDValue(vault.OrganizationID.String())
 	plan.Name = types.StringValue(vault.Name)
 	if vault.Description != "" {
 		plan.Description = types.StringValue(vault.Description)
@@ -169,10 +228,11 @@ func (r *VaultResource) Create(ctx context.Context, req resource.CreateRequest,
 	} else {
 		plan.SyncStatus = types.StringNull()
 	}
-	plan.CreatedAt = types.StringValue(vault.CreatedAt)
+	plan.CreatedAt = NewTimestampValue(vault.CreatedAt)
 
 	// Save data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	resp.Diagnostics.Append(setETag(ctx, resp.Private, vault.ETag)...)
 }
 
 // Read refreshes the Terraform state with the latest data.
@@ -185,19 +245,34 @@ func (r *VaultResource) Read(ctx context.Context, req resource.ReadRequest, resp
 		return
 	}
 
+	ctx, cancel, diags := withOperationTimeout(ctx, state.Timeouts, opRead, vaultTimeouts)
+	defer cancel()
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Get refreshed vault value from API
 	vault, err := r.client.GetVault(ctx, state.OrganizationID.ValueString(), state.ID.ValueString())
 	if err != nil {
-		resp.Diagnostics.AddError(
+		// Handle 404 - resource was deleted outside Terraform
+		if client.IsNotFound(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+
+		addClientError(
+			&resp.Diagnostics,
 			"Error Reading Vault",
 			fmt.Sprintf("Could not read vault ID %s: %s", state.ID.ValueString(), err.Error()),
+			err,
 		)
 		return
 	}
 
 	// Map response to model
-	state.ID = types.StringValue(vault.ID.String())
-	state.OrganizationID = types.StringValue(vault.OrganizationID.String())
+	state.ID = NewSnowflakeIDValue(vault.ID.String())
+	state.OrganizationID = NewSnowflakeIDValue(vault.OrganizationID.String())
 	state.Name = types.StringValue(vault.Name)
 	if vault.Description != "" {
 		state.Description = types.StringValue(vault.Description)
@@ -209,10 +284,11 @@ func (r *VaultResource) Read(ctx context.Context, req resource.ReadRequest, resp
 	} else {
 		state.SyncStatus = types.StringNull()
 	}
-	state.CreatedAt = types.StringValue(vault.CreatedAt)
+	state.CreatedAt = NewTimestampValue(vault.CreatedAt)
 
 	// Save updated data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	resp.Diagnostics.Append(setETag(ctx, resp.Private, vault.ETag)...)
 }
 
 // Update updates the resource and sets the updated Terraform state on success.
@@ -225,24 +301,41 @@ func (r *VaultResource) Update(ctx context.Context, req resource.UpdateRequest,
 		return
 	}
 
+	ctx, cancel, diags := withOperationTimeout(ctx, plan.Timeouts, opUpdate, vaultTimeouts)
+	defer cancel()
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	etag, diags := getETag(ctx, req.Private)
+	resp.Diagnostics.Append(diags...)
+
 	// Update the vault via the API
 	updateReq := client.UpdateVaultRequest{
 		Name:        plan.Name.ValueString(),
 		Description: plan.Description.ValueString(),
+		IfMatch:     etag,
 	}
 
 	vault, err := r.client.UpdateVault(ctx, plan.OrganizationID.ValueString(), plan.ID.ValueString(), updateReq)
 	if err != nil {
-		resp.Diagnostics.AddError(
+		if client.IsPreconditionFailed(err) {
+			addChangedOutsideTerraformError(&resp.Diagnostics, "Vault", plan.ID.ValueString())
+			return
+		}
+		addClientError(
+			&resp.Diagnostics,
 			"Error Updating Vault",
 			fmt.Sprintf("Could not update vault ID %s: %s", plan.ID.ValueString(), err.Error()),
+			err,
 		)
 		return
 	}
 
 	// Map response to model
-	plan.ID = types.StringValue(vault.ID.String())
-	plan.OrganizationID = types.StringValue(vault.OrganizationID.String())
+	plan.ID = NewSnowflakeIDValue(vault.ID.String())
+	plan.OrganizationID = NewSnowflakeIDValue(vault.OrganizationID.String())
 	plan.Name = types.StringValue(vault.Name)
 	if vault.Description != "" {
 		plan.Description = types.StringValue(vault.Description)
@@ -254,10 +347,11 @@ func (r *VaultResource) Update(ctx context.Context, req resource.UpdateRequest,
 	} else {
 		plan.SyncStatus = types.StringNull()
 	}
-	plan.CreatedAt = types.StringValue(vault.CreatedAt)
+	plan.CreatedAt = NewTimestampValue(vault.CreatedAt)
 
 	// Save updated data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	resp.Diagnostics.Append(setETag(ctx, resp.Private, vault.ETag)...)
 }
 
 // Delete deletes the resource and removes the Terraform state on success.
@@ -270,12 +364,26 @@ func (r *VaultResource) Delete(ctx context.Context, req resource.DeleteRequest,
 		return
 	}
 
+	ctx, cancel, diags := withOperationTimeout(ctx, state.Timeouts, opDelete, vaultTimeouts)
+	defer cancel()
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Delete the vault via the API
 	err := r.client.DeleteVault(ctx, state.OrganizationID.ValueString(), state.ID.ValueString())
 	if err != nil {
-		resp.Diagnostics.AddError(
+		// Handle 404 - resource was already deleted
+		if client.IsNotFound(err) {
+			return
+		}
+
+		addClientError(
+			&resp.Diagnostics,
 			"Error Deleting Vault",
 			fmt.Sprintf("Could not delete vault ID %s: %s", state.ID.ValueString(), err.Error()),
+			err,
 		)
 		return
 	}
@@ -283,20 +391,12 @@ func (r *VaultResource) Delete(ctx context.Context, req resource.DeleteRequest,
 
 // ImportState imports the resource into Terraform state.
 func (r *VaultResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	// Expected import ID format: organization_id/vault_id
-	parts := strings.Split(req.ID, "/")
-	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
-		resp.Diagnostics.AddError(
-			"Invalid Import ID",
-			fmt.Sprintf("Expected import ID format: organization_id/vault_id, got: %s", req.ID),
-		)
+	parts, diags := parseImportID(req.ID, "<org_id>/<vault_id>")
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	organizationID := parts[0]
-	vaultID := parts[1]
-
-	// Set the organization_id and id attributes
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("organization_id"), organizationID)...)
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), vaultID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("organization_id"), parts[0])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), parts[1])...)
 }
@@ -6,13 +6,15 @@ package provider
 import (
 	"context"
 	"fmt"
-	"strings"
 
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/inferadb/terraform-provider-inferadb/internal/client"
 )
@@ -33,13 +35,14 @@ type VaultTeamGrantResource struct {
 
 // VaultTeamGrantResourceModel describes the resource data model.
 type VaultTeamGrantResourceModel struct {
-	ID              types.String `tfsdk:"id"`
-	OrganizationID  types.String `tfsdk:"organization_id"`
-	VaultID         types.String `tfsdk:"vault_id"`
-	TeamID          types.String `tfsdk:"team_id"`
-	Role            types.String `tfsdk:"role"`
-	GrantedAt       types.String `tfsdk:"granted_at"`
-	GrantedByUserID types.String `tfsdk:"granted_by_user_id"`
+	ID              SnowflakeIDValue `tfsdk:"id"`
+	OrganizationID  SnowflakeIDValue `tfsdk:"organization_id"`
+	VaultID         SnowflakeIDValue `tfsdk:"vault_id"`
+	TeamID          SnowflakeIDValue `tfsdk:"team_id"`
+	Role            types.String     `tfsdk:"role"`
+	GrantedAt       TimestampValue   `tfsdk:"granted_at"`
+	GrantedByUserID SnowflakeIDValue `tfsdk:"granted_by_user_id"`
+	Timeouts        timeouts.Value   `tfsdk:"timeouts"`
 }
 
 // Metadata sets the resource type name.
@@ -54,6 +57,7 @@ func (r *VaultTeamGrantResource) Schema(ctx context.Context, req resource.Schema
 
 		Attributes: map[string]schema.Attribute{
 			"id": schema.StringAttribute{
+				CustomType:          SnowflakeIDType{},
 				MarkdownDescription: "Grant ID",
 				Computed:            true,
 				PlanModifiers: []planmodifier.String{
@@ -61,6 +65,7 @@ func (r *VaultTeamGrantResource) Schema(ctx context.Context, req resource.Schema
 				},
 			},
 			"organization_id": schema.StringAttribute{
+				CustomType:          SnowflakeIDType{},
 				MarkdownDescription: "Parent organization ID",
 				Required:            true,
 				PlanModifiers: []planmodifier.String{
@@ -68,6 +73,7 @@ func (r *VaultTeamGrantResource) Schema(ctx context.Context, req resource.Schema
 				},
 			},
 			"vault_id": schema.StringAttribute{
+				CustomType:          SnowflakeIDType{},
 				MarkdownDescription: "Vault ID",
 				Required:            true,
 				PlanModifiers: []planmodifier.String{
@@ -75,6 +81,7 @@ func (r *VaultTeamGrantResource) Schema(ctx context.Context, req resource.Schema
 				},
 			},
 			"team_id": schema.StringAttribute{
+				CustomType:          SnowflakeIDType{},
 				MarkdownDescription: "Team ID",
 				Required:            true,
 				PlanModifiers: []planmodifier.String{
@@ -84,8 +91,10 @@ func (r *VaultTeamGrantResource) Schema(ctx context.Context, req resource.Schema
 			"role": schema.StringAttribute{
 				MarkdownDescription: "Role: reader, writer, manager, admin",
 				Required:            true,
+				Validators:          []validator.String{stringvalidator.OneOf(vaultGrantRoles...)},
 			},
 			"granted_at": schema.StringAttribute{
+				CustomType:          TimestampType{},
 				MarkdownDescription: "ISO 8601 timestamp when grant was created",
 				Computed:            true,
 				PlanModifiers: []planmodifier.String{
@@ -93,6 +102,7 @@ func (r *VaultTeamGrantResource) Schema(ctx context.Context, req resource.Schema
 				},
 			},
 			"granted_by_user_id": schema.StringAttribute{
+				CustomType:          SnowflakeIDType{},
 				MarkdownDescription: "ID of user who created the grant",
 				Computed:            true,
 				PlanModifiers: []planmodifier.String{
@@ -100,6 +110,9 @@ func (r *VaultTeamGrantResource) Schema(ctx context.Context, req resource.Schema
 				},
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeoutsBlock(ctx, defaultTimeouts),
+		},
 	}
 }
 
@@ -131,6 +144,13 @@ func (r *VaultTeamGrantResource) Create(ctx context.Context, req resource.Create
 		return
 	}
 
+	ctx, cancel, diags := withOperationTimeout(ctx, data.Timeouts, opCreate, defaultTimeouts)
+	defer cancel()
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Create the vault team grant
 	grant, err := r.client.CreateVaultTeamGrant(
 		ctx,
@@ -142,17 +162,18 @@ func (r *VaultTeamGrantResource) Create(ctx context.Context, req resource.Create
 		},
 	)
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create vault team grant, got error: %s", err))
+		addClientError(&resp.Diagnostics, "Client Error", fmt.Sprintf("Unable to create vault team grant, got error: %s", err), err)
 		return
 	}
 
 	// Map response to model
-	data.ID = types.StringValue(grant.ID)
-	data.GrantedAt = types.StringValue(grant.GrantedAt)
-	data.GrantedByUserID = types.StringValue(grant.GrantedByUserID)
+	data.ID = NewSnowflakeIDValue(grant.ID)
+	data.GrantedAt = NewTimestampValue(grant.GrantedAt)
+	data.GrantedByUserID = NewSnowflakeIDValue(grant.GrantedByUserID)
 
 	// Save data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(setETag(ctx, resp.Private, grant.ETag)...)
 }
 
 // Read reads the current vault team grant state.
@@ -165,30 +186,43 @@ func (r *VaultTeamGrantResource) Read(ctx context.Context, req resource.ReadRequ
 		return
 	}
 
+	ctx, cancel, diags := withOperationTimeout(ctx, data.Timeouts, opRead, defaultTimeouts)
+	defer cancel()
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Get the vault team grant
-	grant, err := r.client.GetVaultTeamGrant(
+	grant, err := r.client.LookupVaultTeamGrant(
 		ctx,
 		data.OrganizationID.ValueString(),
 		data.VaultID.ValueString(),
 		data.ID.ValueString(),
 	)
+	if err == nil && grant.ETag == "" {
+		// Listed items carry no ETag. Fetch the one of the current version,
+		// so the next update applies only to what this refresh saw.
+		grant, err = r.client.GetVaultTeamGrant(ctx, data.OrganizationID.ValueString(), data.VaultID.ValueString(), data.ID.ValueString())
+	}
 	if err != nil {
-		if apiErr, ok := err.(*client.APIError); ok && apiErr.IsNotFound() {
+		if client.IsNotFound(err) {
 			resp.State.RemoveResource(ctx)
 			return
 		}
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read vault team grant, got error: %s", err))
+		addClientError(&resp.Diagnostics, "Client Error", fmt.Sprintf("Unable to read vault team grant, got error: %s", err), err)
 		return
 	}
 
 	// Map response to model
-	data.TeamID = types.StringValue(grant.TeamID)
+	data.TeamID = NewSnowflakeIDValue(grant.TeamID)
 	data.Role = types.StringValue(grant.Role)
-	data.GrantedAt = types.StringValue(grant.GrantedAt)
-	data.GrantedByUserID = types.StringValue(grant.GrantedByUserID)
+	data.GrantedAt = NewTimestampValue(grant.GrantedAt)
+	data.GrantedByUserID = NewSnowflakeIDValue(grant.GrantedByUserID)
 
 	// Save updated data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(setETag(ctx, resp.Private, grant.ETag)...)
 }
 
 // Update updates an existing vault team grant.
@@ -201,6 +235,16 @@ func (r *VaultTeamGrantResource) Update(ctx context.Context, req resource.Update
 		return
 	}
 
+	ctx, cancel, diags := withOperationTimeout(ctx, data.Timeouts, opUpdate, defaultTimeouts)
+	defer cancel()
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	etag, diags := getETag(ctx, req.Private)
+	resp.Diagnostics.Append(diags...)
+
 	// Update the vault team grant
 	grant, err := r.client.UpdateVaultTeamGrant(
 		ctx,
@@ -208,11 +252,16 @@ func (r *VaultTeamGrantResource) Update(ctx context.Context, req resource.Update
 		data.VaultID.ValueString(),
 		data.ID.ValueString(),
 		client.UpdateVaultTeamGrantRequest{
-			Role: data.Role.ValueString(),
+			Role:    data.Role.ValueString(),
+			IfMatch: etag,
 		},
 	)
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update vault team grant, got error: %s", err))
+		if client.IsPreconditionFailed(err) {
+			addChangedOutsideTerraformError(&resp.Diagnostics, "Vault Team Grant", data.ID.ValueString())
+			return
+		}
+		addClientError(&resp.Diagnostics, "Client Error", fmt.Sprintf("Unable to update vault team grant, got error: %s", err), err)
 		return
 	}
 
@@ -221,6 +270,7 @@ func (r *VaultTeamGrantResource) Update(ctx context.Context, req resource.Update
 
 	// Save updated data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(setETag(ctx, resp.Private, grant.ETag)...)
 }
 
 // Delete deletes a vault team grant.
@@ -233,6 +283,13 @@ func (r *VaultTeamGrantResource) Delete(ctx context.Context, req resource.Delete
 		return
 	}
 
+	ctx, cancel, diags := withOperationTimeout(ctx, data.Timeouts, opDelete, defaultTimeouts)
+	defer cancel()
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Delete the vault team grant
 	err := r.client.DeleteVaultTeamGrant(
 		ctx,
@@ -241,31 +298,24 @@ func (r *VaultTeamGrantResource) Delete(ctx context.Context, req resource.Delete
 		data.ID.ValueString(),
 	)
 	if err != nil {
-		if apiErr, ok := err.(*client.APIError); ok && apiErr.IsNotFound() {
+		if client.IsNotFound(err) {
 			// Resource already deleted, treat as success
 			return
 		}
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete vault team grant, got error: %s", err))
+		addClientError(&resp.Diagnostics, "Client Error", fmt.Sprintf("Unable to delete vault team grant, got error: %s", err), err)
 		return
 	}
 }
 
 // ImportState imports a vault team grant using the format: <org_id>/<vault_id>/<grant_id>
 func (r *VaultTeamGrantResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	parts := strings.Split(req.ID, "/")
-	if len(parts) != 3 {
-		resp.Diagnostics.AddError(
-			"Invalid Import ID",
-			fmt.Sprintf("Expected import ID in format: <org_id>/<vault_id>/<grant_id>, got: %s", req.ID),
-		)
+	parts, diags := parseImportID(req.ID, "<org_id>/<vault_id>/<grant_id>")
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	orgID := parts[0]
-	vaultID := parts[1]
-	grantID := parts[2]
-
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("organization_id"), orgID)...)
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("vault_id"), vaultID)...)
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), grantID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("organization_id"), parts[0])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("vault_id"), parts[1])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), parts[2])...)
 }
@@ -0,0 +1,91 @@
+// Copyright 2025 InferaDB
+// SPDX-License-Identifier: Apache-2.0
+
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+func TestAccVaultTeamGrantResource(t *testing.T) {
+	testCase := testVaultTeamGrantResourceCase()
+	testCase.PreCheck = func() { testAccPreCheck(t) }
+	resource.Test(t, testCase)
+}
+
+func TestVaultTeamGrantResource(t *testing.T) {
+	testFakeControl(t)
+	resource.UnitTest(t, testVaultTeamGrantResourceCase())
+}
+
+func testVaultTeamGrantResourceCase() resource.TestCase {
+	rName := acctest.RandomWithPrefix("tf-test")
+
+	return resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccVaultTeamGrantResourceConfig(rName, "reader"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("inferadb_vault_team_grant.test", "role", "reader"),
+					resource.TestCheckResourceAttrPair("inferadb_vault_team_grant.test", "team_id", "inferadb_team.test", "id"),
+					resource.TestCheckResourceAttrSet("inferadb_vault_team_grant.test", "id"),
+					resource.TestCheckResourceAttrSet("inferadb_vault_team_grant.test", "granted_at"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "inferadb_vault_team_grant.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: func(s *terraform.State) (string, error) {
+					rs, ok := s.RootModule().Resources["inferadb_vault_team_grant.test"]
+					if !ok {
+						return "", fmt.Errorf("resource not found: inferadb_vault_team_grant.test")
+					}
+					return fmt.Sprintf("%s/%s/%s", rs.Primary.Attributes["organization_id"], rs.Primary.Attributes["vault_id"], rs.Primary.ID), nil
+				},
+			},
+			// Update testing - change role
+			{
+				Config: testAccVaultTeamGrantResourceConfig(rName, "manager"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("inferadb_vault_team_grant.test", "role", "manager"),
+				),
+			},
+			// Delete testing is automatic
+		},
+	}
+}
+
+func testAccVaultTeamGrantResourceConfig(orgName, role string) string {
+	return fmt.Sprintf(`
+resource "inferadb_organization" "test" {
+  name = %[1]q
+  tier = "dev"
+}
+
+resource "inferadb_vault" "test" {
+  organization_id = inferadb_organization.test.id
+  name            = "test-vault"
+}
+
+resource "inferadb_team" "test" {
+  organization_id = inferadb_organization.test.id
+  name            = "test-team"
+}
+
+resource "inferadb_vault_team_grant" "test" {
+  organization_id = inferadb_organization.test.id
+  vault_id        = inferadb_vault.test.id
+  team_id         = inferadb_team.test.id
+  role            = %[2]q
+}
+`, orgName, role)
+}
@@ -13,11 +13,21 @@ import (
 )
 
 func TestAccVaultResource(t *testing.T) {
+	testCase := testVaultResourceCase()
+	testCase.PreCheck = func() { testAccPreCheck(t) }
+	resource.Test(t, testCase)
+}
+
+func TestVaultResource(t *testing.T) {
+	testFakeControl(t)
+	resource.UnitTest(t, testVaultResourceCase())
+}
+
+func testVaultResourceCase() resource.TestCase {
 	rName := acctest.RandomWithPrefix("tf-test")
 	vaultName := acctest.RandomWithPrefix("tf-vault")
 
-	resource.Test(t, resource.TestCase{
-		PreCheck:                 func() { testAccPreCheck(t) },
+	return resource.TestCase{
 		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
 		Steps: []resource.TestStep{
 			// Create and Read testing
@@ -54,7 +64,7 @@ func TestAccVaultResource(t *testing.T) {
 			},
 			// Delete testing is automatic
 		},
-	})
+	}
 }
 
 func testAccVaultResourceConfig(orgName, vaultName, description string) string {
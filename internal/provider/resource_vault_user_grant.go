@@ -6,13 +6,15 @@ package provider
 import (
 	"context"
 	"fmt"
-	"strings"
 
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/inferadb/terraform-provider-inferadb/internal/client"
 )
@@ -33,13 +35,14 @@ type VaultUserGrantResource struct {
 
 // VaultUserGrantResourceModel describes the resource data model.
 type VaultUserGrantResourceModel struct {
-	ID              types.String `tfsdk:"id"`
-	OrganizationID  types.String `tfsdk:"organization_id"`
-	VaultID         types.String `tfsdk:"vault_id"`
-	UserID          types.String `tfsdk:"user_id"`
-	Role            types.String `tfsdk:"role"`
-	GrantedAt       types.String `tfsdk:"granted_at"`
-	GrantedByUserID types.String `tfsdk:"granted_by_user_id"`
+	ID              SnowflakeIDValue `tfsdk:"id"`
+	OrganizationID  SnowflakeIDValue `tfsdk:"organization_id"`
+	VaultID         SnowflakeIDValue `tfsdk:"vault_id"`
+	UserID          SnowflakeIDValue `tfsdk:"user_id"`
+	Role            types.String     `tfsdk:"role"`
+	GrantedAt       TimestampValue   `tfsdk:"granted_at"`
+	GrantedByUserID SnowflakeIDValue `tfsdk:"granted_by_user_id"`
+	Timeouts        timeouts.Value   `tfsdk:"timeouts"`
 }
 
 // Metadata sets the resource type name.
@@ -54,6 +57,7 @@ func (r *VaultUserGrantResource) Schema(ctx context.Context, req resource.Schema
 
 		Attributes: map[string]schema.Attribute{
 			"id": schema.StringAttribute{
+				CustomType:          SnowflakeIDType{},
 				MarkdownDescription: "Grant ID",
 				Computed:            true,
 				PlanModifiers: []planmodifier.String{
@@ -61,6 +65,7 @@ func (r *VaultUserGrantResource) Schema(ctx context.Context, req resource.Schema
 				},
 			},
 			"organization_id": schema.StringAttribute{
+				CustomType:          SnowflakeIDType{},
 				MarkdownDescription: "Parent organization ID",
 				Required:            true,
 				PlanModifiers: []planmodifier.String{
@@ -68,6 +73,7 @@ func (r *VaultUserGrantResource) Schema(ctx context.Context, req resource.Schema
 				},
 			},
 			"vault_id": schema.StringAttribute{
+				CustomType:          SnowflakeIDType{},
 				MarkdownDescription: "Vault ID",
 				Required:            true,
 				PlanModifiers: []planmodifier.String{
@@ -75,6 +81,7 @@ func (r *VaultUserGrantResource) Schema(ctx context.Context, req resource.Schema
 				},
 			},
 			"user_id": schema.StringAttribute{
+				CustomType:          SnowflakeIDType{},
 				MarkdownDescription: "User ID",
 				Required:            true,
 				PlanModifiers: []planmodifier.String{
@@ -84,8 +91,10 @@ func (r *VaultUserGrantResource) Schema(ctx context.Context, req resource.Schema
 			"role": schema.StringAttribute{
 				MarkdownDescription: "Role: reader, writer, manager, admin",
 				Required:            true,
+				Validators:          []validator.String{stringvalidator.OneOf(vaultGrantRoles...)},
 			},
 			"granted_at": schema.StringAttribute{
+				CustomType:          TimestampType{},
 				MarkdownDescription: "ISO 8601 timestamp when grant was created",
 				Computed:            true,
 				PlanModifiers: []planmodifier.String{
@@ -93,6 +102,7 @@ func (r *VaultUserGrantResource) Schema(ctx context.Context, req resource.Schema
 				},
 			},
 			"granted_by_user_id": schema.StringAttribute{
+				CustomType:          SnowflakeIDType{},
 				MarkdownDescription: "ID of user who created the grant",
 				Computed:            true,
 				PlanModifiers: []planmodifier.String{
@@ -100,6 +110,9 @@ func (r *VaultUserGrantResource) Schema(ctx context.Context, req resource.Schema
 				},
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeoutsBlock(ctx, defaultTimeouts),
+		},
 	}
 }
 
@@ -130,6 +143,13 @@ func (r *VaultUserGrantResource) Create(ctx context.Context, req resource.Create
 		return
 	}
 
+	ctx, cancel, diags := withOperationTimeout(ctx, data.Timeouts, opCreate, defaultTimeouts)
+	defer cancel()
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	grant, err := r.client.CreateVaultUserGrant(ctx,
 		data.OrganizationID.ValueString(),
 		data.VaultID.ValueString(),
@@ -139,15 +159,16 @@ func (r *VaultUserGrantResource) Create(ctx context.Context, req resource.Create
 		},
 	)
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create vault user grant: %s", err))
+		addClientError(&resp.Diagnostics, "Client Error", fmt.Sprintf("Unable to create vault user grant: %s", err), err)
 		return
 	}
 
-	data.ID = types.StringValue(grant.ID)
-	data.GrantedAt = types.StringValue(grant.GrantedAt)
-	data.GrantedByUserID = types.StringValue(grant.GrantedByUserID)
+	data.ID = NewSnowflakeIDValue(grant.ID)
+	data.GrantedAt = NewTimestampValue(grant.GrantedAt)
+	data.GrantedByUserID = NewSnowflakeIDValue(grant.GrantedByUserID)
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(setETag(ctx, resp.Private, grant.ETag)...)
 }
 
 // Read reads the current vault user grant state.
@@ -159,26 +180,39 @@ func (r *VaultUserGrantResource) Read(ctx context.Context, req resource.ReadRequ
 		return
 	}
 
-	grant, err := r.client.GetVaultUserGrant(ctx,
+	ctx, cancel, diags := withOperationTimeout(ctx, data.Timeouts, opRead, defaultTimeouts)
+	defer cancel()
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	grant, err := r.client.LookupVaultUserGrant(ctx,
 		data.OrganizationID.ValueString(),
 		data.VaultID.ValueString(),
 		data.ID.ValueString(),
 	)
+	if err == nil && grant.ETag == "" {
+		// Listed items carry no ETag. Fetch the one of the current version,
+		// so the next update applies only to what this refresh saw.
+		grant, err = r.client.GetVaultUserGrant(ctx, data.OrganizationID.ValueString(), data.VaultID.ValueString(), data.ID.ValueString())
+	}
 	if err != nil {
-		if apiErr, ok := err.(*client.APIError); ok && apiErr.IsNotFound() {
+		if client.IsNotFound(err) {
 			resp.State.RemoveResource(ctx)
 			return
 		}
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read vault user grant: %s", err))
+		addClientError(&resp.Diagnostics, "Client Error", fmt.Sprintf("Unable to read vault user grant: %s", err), err)
 		return
 	}
 
-	data.UserID = types.StringValue(grant.UserID)
+	data.UserID = NewSnowflakeIDValue(grant.UserID)
 	data.Role = types.StringValue(grant.Role)
-	data.GrantedAt = types.StringValue(grant.GrantedAt)
-	data.GrantedByUserID = types.StringValue(grant.GrantedByUserID)
+	data.GrantedAt = NewTimestampValue(grant.GrantedAt)
+	data.GrantedByUserID = NewSnowflakeIDValue(grant.GrantedByUserID)
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(setETag(ctx, resp.Private, grant.ETag)...)
 }
 
 // Update updates an existing vault user grant.
@@ -190,22 +224,38 @@ func (r *VaultUserGrantResource) Update(ctx context.Context, req resource.Update
 		return
 	}
 
+	ctx, cancel, diags := withOperationTimeout(ctx, data.Timeouts, opUpdate, defaultTimeouts)
+	defer cancel()
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	etag, diags := getETag(ctx, req.Private)
+	resp.Diagnostics.Append(diags...)
+
 	grant, err := r.client.UpdateVaultUserGrant(ctx,
 		data.OrganizationID.ValueString(),
 		data.VaultID.ValueString(),
 		data.ID.ValueString(),
 		client.UpdateVaultUserGrantRequest{
-			Role: data.Role.ValueString(),
+			Role:    data.Role.ValueString(),
+			IfMatch: etag,
 		},
 	)
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update vault user grant: %s", err))
+		if client.IsPreconditionFailed(err) {
+			addChangedOutsideTerraformError(&resp.Diagnostics, "Vault User Grant", data.ID.ValueString())
+			return
+		}
+		addClientError(&resp.Diagnostics, "Client Error", fmt.Sprintf("Unable to update vault user grant: %s", err), err)
 		return
 	}
 
 	data.Role = types.StringValue(grant.Role)
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(setETag(ctx, resp.Private, grant.ETag)...)
 }
 
 // Delete deletes a vault user grant.
@@ -217,28 +267,32 @@ func (r *VaultUserGrantResource) Delete(ctx context.Context, req resource.Delete
 		return
 	}
 
+	ctx, cancel, diags := withOperationTimeout(ctx, data.Timeouts, opDelete, defaultTimeouts)
+	defer cancel()
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	err := r.client.DeleteVaultUserGrant(ctx,
 		data.OrganizationID.ValueString(),
 		data.VaultID.ValueString(),
 		data.ID.ValueString(),
 	)
 	if err != nil {
-		if apiErr, ok := err.(*client.APIError); ok && apiErr.IsNotFound() {
+		if client.IsNotFound(err) {
 			return
 		}
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete vault user grant: %s", err))
+		addClientError(&resp.Diagnostics, "Client Error", fmt.Sprintf("Unable to delete vault user grant: %s", err), err)
 		return
 	}
 }
 
 // ImportState imports a vault user grant using the format: <org_id>/<vault_id>/<grant_id>
 func (r *VaultUserGrantResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	parts := strings.Split(req.ID, "/")
-	if len(parts) != 3 {
-		resp.Diagnostics.AddError(
-			"Invalid Import ID",
-			fmt.Sprintf("Expected import ID in format: <org_id>/<vault_id>/<grant_id>, got: %s", req.ID),
-		)
+	parts, diags := parseImportID(req.ID, "<org_id>/<vault_id>/<grant_id>")
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
 		return
 	}
 
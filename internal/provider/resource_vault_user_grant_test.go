@@ -0,0 +1,80 @@
+// Copyright 2025 InferaDB
+// SPDX-License-Identifier: Apache-2.0
+
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+// User grants need an existing user, so this test only runs against the
+// fake Control API.
+func TestVaultUserGrantResource(t *testing.T) {
+	srv := testFakeControl(t)
+	userID := srv.AddUser("reader@example.com")
+	rName := acctest.RandomWithPrefix("tf-test")
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccVaultUserGrantResourceConfig(rName, userID, "reader"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("inferadb_vault_user_grant.test", "user_id", userID),
+					resource.TestCheckResourceAttr("inferadb_vault_user_grant.test", "role", "reader"),
+					resource.TestCheckResourceAttr("inferadb_vault_user_grant.test", "granted_by_user_id", srv.UserID),
+					resource.TestCheckResourceAttrSet("inferadb_vault_user_grant.test", "id"),
+					resource.TestCheckResourceAttrSet("inferadb_vault_user_grant.test", "granted_at"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "inferadb_vault_user_grant.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: func(s *terraform.State) (string, error) {
+					rs, ok := s.RootModule().Resources["inferadb_vault_user_grant.test"]
+					if !ok {
+						return "", fmt.Errorf("resource not found: inferadb_vault_user_grant.test")
+					}
+					return fmt.Sprintf("%s/%s/%s", rs.Primary.Attributes["organization_id"], rs.Primary.Attributes["vault_id"], rs.Primary.ID), nil
+				},
+			},
+			// Update testing - change role
+			{
+				Config: testAccVaultUserGrantResourceConfig(rName, userID, "writer"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("inferadb_vault_user_grant.test", "role", "writer"),
+				),
+			},
+			// Delete testing is automatic
+		},
+	})
+}
+
+func testAccVaultUserGrantResourceConfig(orgName, userID, role string) string {
+	return fmt.Sprintf(`
+resource "inferadb_organization" "test" {
+  name = %[1]q
+  tier = "dev"
+}
+
+resource "inferadb_vault" "test" {
+  organization_id = inferadb_organization.test.id
+  name            = "test-vault"
+}
+
+resource "inferadb_vault_user_grant" "test" {
+  organization_id = inferadb_organization.test.id
+  vault_id        = inferadb_vault.test.id
+  user_id         = %[2]q
+  role            = %[3]q
+}
+`, orgName, userID, role)
+}
@@ -0,0 +1,148 @@
+// Copyright 2025 InferaDB
+// SPDX-License-Identifier: Apache-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/attr/xattr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/inferadb/terraform-provider-inferadb/internal/client"
+)
+
+var (
+	_ basetypes.StringTypable     = SnowflakeIDType{}
+	_ basetypes.StringValuable    = SnowflakeIDValue{}
+	_ xattr.ValidateableAttribute = SnowflakeIDValue{}
+)
+
+// SnowflakeIDType is the attribute type of snowflake IDs such as id,
+// organization_id and vault_id. Configured values are validated at plan
+// time, before any request reaches the Control API.
+type SnowflakeIDType struct {
+	basetypes.StringType
+}
+
+// Equal returns true if o is also a SnowflakeIDType.
+func (t SnowflakeIDType) Equal(o attr.Type) bool {
+	other, ok := o.(SnowflakeIDType)
+	if !ok {
+		return false
+	}
+	return t.StringType.Equal(other.StringType)
+}
+
+// String returns a human readable name of the type.
+func (t SnowflakeIDType) String() string {
+	return "SnowflakeIDType"
+}
+
+// ValueFromString wraps a string value as a SnowflakeIDValue.
+func (t SnowflakeIDType) ValueFromString(ctx context.Context, in basetypes.StringValue) (basetypes.StringValuable, diag.Diagnostics) {
+	return SnowflakeIDValue{StringValue: in}, nil
+}
+
+// ValueFromTerraform converts a Terraform value to a SnowflakeIDValue.
+func (t SnowflakeIDType) ValueFromTerraform(ctx context.Context, in tftypes.Value) (attr.Value, error) {
+	attrValue, err := t.StringType.ValueFromTerraform(ctx, in)
+	if err != nil {
+		return nil, err
+	}
+
+	stringValue, ok := attrValue.(basetypes.StringValue)
+	if !ok {
+		return nil, fmt.Errorf("unexpected value type of %T", attrValue)
+	}
+
+	stringValuable, diags := t.ValueFromString(ctx, stringValue)
+	if diags.HasError() {
+		return nil, fmt.Errorf("unexpected error converting StringValue to StringValuable: %v", diags)
+	}
+	return stringValuable, nil
+}
+
+// ValueType returns the value type of SnowflakeIDType.
+func (t SnowflakeIDType) ValueType(ctx context.Context) attr.Value {
+	return SnowflakeIDValue{}
+}
+
+// SnowflakeIDValue is a snowflake ID held as its exact decimal string.
+type SnowflakeIDValue struct {
+	basetypes.StringValue
+}
+
+// NewSnowflakeIDValue returns a known SnowflakeIDValue.
+func NewSnowflakeIDValue(id string) SnowflakeIDValue {
+	return SnowflakeIDValue{StringValue: basetypes.NewStringValue(id)}
+}
+
+// NewSnowflakeIDNull returns a null SnowflakeIDValue.
+func NewSnowflakeIDNull() SnowflakeIDValue {
+	return SnowflakeIDValue{StringValue: basetypes.NewStringNull()}
+}
+
+// Equal returns true if o is a SnowflakeIDValue with the same state and ID.
+func (v SnowflakeIDValue) Equal(o attr.Value) bool {
+	other, ok := o.(SnowflakeIDValue)
+	if !ok {
+		return false
+	}
+	return v.StringValue.Equal(other.StringValue)
+}
+
+// Type returns SnowflakeIDType.
+func (v SnowflakeIDValue) Type(ctx context.Context) attr.Type {
+	return SnowflakeIDType{}
+}
+
+// ValidateAttribute rejects configured IDs that are not the canonical decimal
+// form of a 64-bit snowflake ID.
+func (v SnowflakeIDValue) ValidateAttribute(ctx context.Context, req xattr.ValidateAttributeRequest, resp *xattr.ValidateAttributeResponse) {
+	if v.IsNull() || v.IsUnknown() {
+		return
+	}
+
+	if _, err := client.ParseSnowflakeID(v.ValueString()); err != nil {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid Snowflake ID",
+			fmt.Sprintf("The value is not a valid InferaDB ID: %s. IDs are unsigned 64-bit integers written in decimal, e.g. \"7160832093298688\".", err),
+		)
+	}
+}
+
+// parseImportID splits an import ID into the snowflake IDs named by format,
+// e.g. "<org_id>/<team_id>", rejecting IDs with the wrong number of parts or
+// a part that is not a valid snowflake ID.
+func parseImportID(id, format string) ([]string, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	names := strings.Split(format, "/")
+	parts := strings.Split(strings.TrimSpace(id), "/")
+	if len(parts) != len(names) {
+		diags.AddError(
+			"Invalid Import ID",
+			fmt.Sprintf("Expected import ID in format: %s, got: %s", format, id),
+		)
+		return nil, diags
+	}
+
+	for i, part := range parts {
+		if _, err := client.ParseSnowflakeID(part); err != nil {
+			diags.AddError(
+				"Invalid Import ID",
+				fmt.Sprintf("The %s part of import ID %s is not a valid InferaDB ID: %s. IDs are unsigned 64-bit integers written in decimal, e.g. \"7160832093298688\".", names[i], id, err),
+			)
+		}
+	}
+	if diags.HasError() {
+		return nil, diags
+	}
+	return parts, diags
+}
@@ -0,0 +1,71 @@
+// Copyright 2025 InferaDB
+// SPDX-License-Identifier: Apache-2.0
+
+package provider
+
+import (
+	"reflect"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestSnowflakeIDValidation(t *testing.T) {
+	testFakeControl(t)
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+resource "inferadb_vault" "test" {
+  organization_id = "org-123"
+  name            = "invalid-org"
+}
+`,
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`Invalid Snowflake ID`),
+			},
+			{
+				Config: `
+data "inferadb_team" "test" {
+  organization_id = "18446744073709551616"
+  id              = "1"
+}
+`,
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`Invalid Snowflake ID`),
+			},
+		},
+	})
+}
+
+func TestParseImportID(t *testing.T) {
+	const format = "<org_id>/<team_id>/<member_id>"
+	tests := []struct {
+		name string
+		id   string
+		want []string
+	}{
+		{"valid", "7160832093298688/7160832126853120/3", []string{"7160832093298688", "7160832126853120", "3"}},
+		{"surrounding whitespace", " 1/2/3 ", []string{"1", "2", "3"}},
+		{"too few parts", "1/2", nil},
+		{"too many parts", "1/2/3/4", nil},
+		{"empty part", "1//3", nil},
+		{"prefixed part", "org_123/team_456/member_789", nil},
+		{"out of range", "1/2/18446744073709551616", nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, diags := parseImportID(tt.id, format)
+			if diags.HasError() != (tt.want == nil) {
+				t.Fatalf("parseImportID(%q) diagnostics = %v", tt.id, diags)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("parseImportID(%q) = %q, want %q", tt.id, got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,297 @@
+// Copyright 2025 InferaDB
+// SPDX-License-Identifier: Apache-2.0
+
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"os"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/inferadb/terraform-provider-inferadb/internal/client"
+)
+
+// sweepPrefix is the name prefix of the organizations created by acceptance
+// tests. Everything inside such an organization is considered leaked.
+const sweepPrefix = "tf-test"
+
+// Sweepers run with `go test ./internal/provider -v -sweep=all` and delete
+// the objects failed acceptance runs leave behind, children first. The
+// region argument is ignored.
+func init() {
+	resource.AddTestSweepers("inferadb_client_certificate", &resource.Sweeper{
+		Name: "inferadb_client_certificate",
+		F:    sweepClientCertificates,
+	})
+	resource.AddTestSweepers("inferadb_client", &resource.Sweeper{
+		Name:         "inferadb_client",
+		Dependencies: []string{"inferadb_client_certificate"},
+		F:            sweepClients,
+	})
+	resource.AddTestSweepers("inferadb_vault_user_grant", &resource.Sweeper{
+		Name:         "inferadb_vault_user_grant",
+		Dependencies: []string{"inferadb_client"},
+		F:            sweepVaultUserGrants,
+	})
+	resource.AddTestSweepers("inferadb_vault_team_grant", &resource.Sweeper{
+		Name:         "inferadb_vault_team_grant",
+		Dependencies: []string{"inferadb_client"},
+		F:            sweepVaultTeamGrants,
+	})
+	resource.AddTestSweepers("inferadb_team_member", &resource.Sweeper{
+		Name:         "inferadb_team_member",
+		Dependencies: []string{"inferadb_vault_user_grant", "inferadb_vault_team_grant"},
+		F:            sweepTeamMembers,
+	})
+	resource.AddTestSweepers("inferadb_team", &resource.Sweeper{
+		Name:         "inferadb_team",
+		Dependencies: []string{"inferadb_team_member"},
+		F:            sweepTeams,
+	})
+	resource.AddTestSweepers("inferadb_vault", &resource.Sweeper{
+		Name:         "inferadb_vault",
+		Dependencies: []string{"inferadb_team"},
+		F:            sweepVaults,
+	})
+	resource.AddTestSweepers("inferadb_organization", &resource.Sweeper{
+		Name:         "inferadb_organization",
+		Dependencies: []string{"inferadb_vault"},
+		F:            sweepOrganizations,
+	})
+}
+
+// sweeperClient returns a client for the Control API the acceptance tests
+// ran against.
+func sweeperClient() (*client.Client, error) {
+	endpoint := os.Getenv("INFERADB_ENDPOINT")
+	sessionToken := os.Getenv("INFERADB_SESSION_TOKEN")
+	if endpoint == "" || sessionToken == "" {
+		return nil, errors.New("INFERADB_ENDPOINT and INFERADB_SESSION_TOKEN must be set to run sweepers")
+	}
+	return client.New(client.Config{Endpoint: endpoint, SessionToken: sessionToken, CacheTTL: -1}), nil
+}
+
+// testOrganizations returns the organizations created by acceptance tests.
+func testOrganizations(ctx context.Context, c *client.Client) ([]client.Organization, error) {
+	orgs, err := c.ListOrganizations(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var leaked []client.Organization
+	for _, org := range orgs {
+		if strings.HasPrefix(org.Name, sweepPrefix) {
+			leaked = append(leaked, org)
+		}
+	}
+	return leaked, nil
+}
+
+// sweepEach calls fn for every organization created by acceptance tests and
+// joins the errors, so one failure does not stop the sweep.
+func sweepEach(fn func(ctx context.Context, c *client.Client, orgID string) error) error {
+	c, err := sweeperClient()
+	if err != nil {
+		return err
+	}
+	ctx := context.Background()
+
+	orgs, err := testOrganizations(ctx, c)
+	if err != nil {
+		return fmt.Errorf("error listing organizations: %w", err)
+	}
+
+	var errs []error
+	for _, org := range orgs {
+		if err := fn(ctx, c, org.ID.String()); err != nil {
+			errs = append(errs, fmt.Errorf("organization %s (%s): %w", org.Name, org.ID, err))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// sweepDelete deletes one object, treating objects that are already gone as
+// deleted.
+func sweepDelete(kind, id string, del func() error) error {
+	log.Printf("[INFO] Deleting %s %s", kind, id)
+	if err := del(); err != nil && !client.IsNotFound(err) {
+		return fmt.Errorf("error deleting %s %s: %w", kind, id, err)
+	}
+	return nil
+}
+
+func sweepClientCertificates(_ string) error {
+	return sweepEach(func(ctx context.Context, c *client.Client, orgID string) error {
+		clients, err := c.ListClients(ctx, orgID)
+		if err != nil {
+			return err
+		}
+
+		var errs []error
+		for _, apiClient := range clients {
+			clientID := apiClient.ID.String()
+			certs, err := c.ListCertificates(ctx, orgID, clientID)
+			if err != nil {
+				errs = append(errs, err)
+				continue
+			}
+			for _, cert := range certs {
+				errs = append(errs, sweepDelete("client certificate", cert.ID, func() error {
+					return c.DeleteCertificate(ctx, orgID, clientID, cert.ID)
+				}))
+			}
+		}
+		return errors.Join(errs...)
+	})
+}
+
+func sweepClients(_ string) error {
+	return sweepEach(func(ctx context.Context, c *client.Client, orgID string) error {
+		clients, err := c.ListClients(ctx, orgID)
+		if err != nil {
+			return err
+		}
+
+		var errs []error
+		for _, apiClient := range clients {
+			errs = append(errs, sweepDelete("client", apiClient.ID.String(), func() error {
+				return c.DeleteClient(ctx, orgID, apiClient.ID.String())
+			}))
+		}
+		return errors.Join(errs...)
+	})
+}
+
+func sweepVaultUserGrants(_ string) error {
+	return sweepEach(func(ctx context.Context, c *client.Client, orgID string) error {
+		vaults, err := c.ListVaults(ctx, orgID)
+		if err != nil {
+			return err
+		}
+
+		var errs []error
+		for _, vault := range vaults {
+			vaultID := vault.ID.String()
+			grants, err := c.ListVaultUserGrants(ctx, orgID, vaultID)
+			if err != nil {
+				errs = append(errs, err)
+				continue
+			}
+			for _, grant := range grants {
+				errs = append(errs, sweepDelete("vault user grant", grant.ID, func() error {
+					return c.DeleteVaultUserGrant(ctx, orgID, vaultID, grant.ID)
+				}))
+			}
+		}
+		return errors.Join(errs...)
+	})
+}
+
+func sweepVaultTeamGrants(_ string) error {
+	return sweepEach(func(ctx context.Context, c *client.Client, orgID string) error {
+		vaults, err := c.ListVaults(ctx, orgID)
+		if err != nil {
+			return err
+		}
+
+		var errs []error
+		for _, vault := range vaults {
+			vaultID := vault.ID.String()
+			grants, err := c.ListVaultTeamGrants(ctx, orgID, vaultID)
+			if err != nil {
+				errs = append(errs, err)
+				continue
+			}
+			for _, grant := range grants {
+				errs = append(errs, sweepDelete("vault team grant", grant.ID, func() error {
+					return c.DeleteVaultTeamGrant(ctx, orgID, vaultID, grant.ID)
+				}))
+			}
+		}
+		return errors.Join(errs...)
+	})
+}
+
+func sweepTeamMembers(_ string) error {
+	return sweepEach(func(ctx context.Context, c *client.Client, orgID string) error {
+		teams, err := c.ListTeams(ctx, orgID)
+		if err != nil {
+			return err
+		}
+
+		var errs []error
+		for _, team := range teams {
+			teamID := team.ID.String()
+			members, err := c.ListTeamMembers(ctx, orgID, teamID)
+			if err != nil {
+				errs = append(errs, err)
+				continue
+			}
+			for _, member := range members {
+				errs = append(errs, sweepDelete("team member", member.ID, func() error {
+					return c.RemoveTeamMember(ctx, orgID, teamID, member.ID)
+				}))
+			}
+		}
+		return errors.Join(errs...)
+	})
+}
+
+func sweepTeams(_ string) error {
+	return sweepEach(func(ctx context.Context, c *client.Client, orgID string) error {
+		teams, err := c.ListTeams(ctx, orgID)
+		if err != nil {
+			return err
+		}
+
+		var errs []error
+		for _, team := range teams {
+			errs = append(errs, sweepDelete("team", team.ID.String(), func() error {
+				return c.DeleteTeam(ctx, orgID, team.ID.String())
+			}))
+		}
+		return errors.Join(errs...)
+	})
+}
+
+func sweepVaults(_ string) error {
+	return sweepEach(func(ctx context.Context, c *client.Client, orgID string) error {
+		vaults, err := c.ListVaults(ctx, orgID)
+		if err != nil {
+			return err
+		}
+
+		var errs []error
+		for _, vault := range vaults {
+			errs = append(errs, sweepDelete("vault", vault.ID.String(), func() error {
+				return c.DeleteVault(ctx, orgID, vault.ID.String())
+			}))
+		}
+		return errors.Join(errs...)
+	})
+}
+
+func sweepOrganizations(_ string) error {
+	c, err := sweeperClient()
+	if err != nil {
+		return err
+	}
+	ctx := context.Background()
+
+	orgs, err := testOrganizations(ctx, c)
+	if err != nil {
+		return fmt.Errorf("error listing organizations: %w", err)
+	}
+
+	var errs []error
+	for _, org := range orgs {
+		errs = append(errs, sweepDelete("organization", org.Name, func() error {
+			return c.DeleteOrganization(ctx, org.ID.String())
+		}))
+	}
+	return errors.Join(errs...)
+}
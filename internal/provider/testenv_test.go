@@ -0,0 +1,226 @@
+// Copyright 2025 InferaDB
+// SPDX-License-Identifier: Apache-2.0
+
+package provider
+
+import (
+	"bytes"
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"mime/quotedprintable"
+	"net/http"
+	"net/url"
+	"os"
+	"regexp"
+	"strings"
+	"time"
+)
+
+// Defaults of the local test environment started with docker-compose.test.yml.
+const (
+	testEnvDefaultBaseURL    = "http://localhost:9090"
+	testEnvDefaultMailHogAPI = "http://localhost:8025"
+	testEnvDefaultTimeout    = 120 * time.Second
+)
+
+// testEnvSkipReason is set when the acceptance test environment could not be
+// bootstrapped. testAccPreCheck skips with it instead of failing every test,
+// unless the environment is required.
+var testEnvSkipReason string
+
+// testEnvRequired reports whether acceptance tests must fail rather than skip
+// when no environment is available, as in CI where a skipped run would pass
+// silently. It is set with INFERADB_REQUIRE_TEST_ENV=1.
+func testEnvRequired() bool {
+	return os.Getenv("INFERADB_REQUIRE_TEST_ENV") != ""
+}
+
+// verifyEmailTokenRe extracts the token of the verification link sent on
+// registration.
+var verifyEmailTokenRe = regexp.MustCompile(`verify-email\?token=([a-zA-Z0-9_-]+)`)
+
+// testEnvBootstrap prepares the environment for acceptance tests. When TF_ACC
+// is set and no session token was provided, it waits for the Control service
+// to become healthy, registers a unique test user, verifies its email through
+// MailHog and exports INFERADB_ENDPOINT and INFERADB_SESSION_TOKEN for the
+// provider factories and the sweepers. Registration signs the user in, so its
+// session is used.
+//
+// The environment is read from INFERADB_BASE_URL (the Control server, default
+// http://localhost:9090), INFERADB_ENDPOINT (default: the base URL with the
+// /control prefix), MAILHOG_API (default http://localhost:8025) and
+// INFERADB_BOOTSTRAP_TIMEOUT (a duration, default 2m).
+func testEnvBootstrap() {
+	if os.Getenv("TF_ACC") == "" || os.Getenv("INFERADB_SESSION_TOKEN") != "" {
+		return
+	}
+
+	baseURL := strings.TrimSuffix(envOrDefault("INFERADB_BASE_URL", testEnvDefaultBaseURL), "/")
+	endpoint := envOrDefault("INFERADB_ENDPOINT", baseURL+"/control")
+	mailHogAPI := strings.TrimSuffix(envOrDefault("MAILHOG_API", testEnvDefaultMailHogAPI), "/")
+
+	timeout := testEnvDefaultTimeout
+	if v := os.Getenv("INFERADB_BOOTSTRAP_TIMEOUT"); v != "" {
+		d, err := time.ParseDuration(v)
+		if err != nil {
+			testEnvSkipReason = fmt.Sprintf("invalid INFERADB_BOOTSTRAP_TIMEOUT %q: %s", v, err)
+			return
+		}
+		timeout = d
+	}
+
+	sessionToken, err := bootstrapTestUser(baseURL, endpoint, mailHogAPI, timeout)
+	if err != nil {
+		testEnvSkipReason = fmt.Sprintf("acceptance test environment at %s is not available: %s", baseURL, err)
+		log.Printf("[WARN] %s", testEnvSkipReason)
+		return
+	}
+
+	_ = os.Setenv("INFERADB_ENDPOINT", endpoint)
+	_ = os.Setenv("INFERADB_SESSION_TOKEN", sessionToken)
+	log.Printf("[INFO] Bootstrapped acceptance test user against %s", endpoint)
+}
+
+// bootstrapTestUser registers and verifies a new user and returns its
+// session token.
+func bootstrapTestUser(baseURL, endpoint, mailHogAPI string, timeout time.Duration) (string, error) {
+	httpClient := &http.Client{Timeout: 10 * time.Second}
+	deadline := time.Now().Add(timeout)
+
+	if err := waitForHealthy(httpClient, baseURL+"/healthz", deadline); err != nil {
+		return "", err
+	}
+
+	suffix := make([]byte, 4)
+	_, _ = rand.Read(suffix)
+	email := fmt.Sprintf("tf-test-%d-%s@test.inferadb.local", time.Now().Unix(), hex.EncodeToString(suffix))
+	password := "TestPassword123!" + hex.EncodeToString(suffix)
+
+	resp, err := postJSON(httpClient, endpoint+"/v1/auth/register", map[string]string{
+		"email":    email,
+		"password": password,
+		"name":     envOrDefault("INFERADB_TEST_USER_NAME", "Terraform Test User"),
+	})
+	if err != nil {
+		return "", fmt.Errorf("failed to register test user: %w", err)
+	}
+	var sessionToken string
+	for _, cookie := range resp.Cookies() {
+		if cookie.Name == "infera_session" {
+			sessionToken = cookie.Value
+		}
+	}
+	if sessionToken == "" {
+		return "", errors.New("registration response has no infera_session cookie")
+	}
+
+	// Organizations can only be created once the email is verified.
+	token, err := waitForVerificationToken(httpClient, mailHogAPI, email, deadline)
+	if err != nil {
+		return "", err
+	}
+	if _, err := postJSON(httpClient, endpoint+"/v1/auth/verify-email", map[string]string{"token": token}); err != nil {
+		return "", fmt.Errorf("failed to verify test user email: %w", err)
+	}
+
+	return sessionToken, nil
+}
+
+// waitForHealthy polls healthURL until it answers 200 OK or the deadline passes.
+func waitForHealthy(httpClient *http.Client, healthURL string, deadline time.Time) error {
+	for {
+		resp, err := httpClient.Get(healthURL)
+		if err == nil {
+			_ = resp.Body.Close()
+			if resp.StatusCode == http.StatusOK {
+				return nil
+			}
+			err = fmt.Errorf("status %d", resp.StatusCode)
+		}
+		if time.Now().After(deadline) {
+			return fmt.Errorf("%s did not become healthy: %w", healthURL, err)
+		}
+		time.Sleep(2 * time.Second)
+	}
+}
+
+// waitForVerificationToken polls MailHog for the verification email sent to
+// email and returns its token.
+func waitForVerificationToken(httpClient *http.Client, mailHogAPI, email string, deadline time.Time) (string, error) {
+	for {
+		token, err := findVerificationToken(httpClient, mailHogAPI, email)
+		if err == nil && token != "" {
+			return token, nil
+		}
+		if time.Now().After(deadline) {
+			if err == nil {
+				err = errors.New("no verification email received")
+			}
+			return "", fmt.Errorf("failed to verify test user email via MailHog at %s: %w", mailHogAPI, err)
+		}
+		time.Sleep(time.Second)
+	}
+}
+
+func findVerificationToken(httpClient *http.Client, mailHogAPI, email string) (string, error) {
+	resp, err := httpClient.Get(mailHogAPI + "/api/v2/search?kind=to&query=" + url.QueryEscape(email))
+	if err != nil {
+		return "", err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return "", fmt.Errorf("MailHog search returned status %d", resp.StatusCode)
+	}
+
+	var messages struct {
+		Items []struct {
+			Content struct {
+				Body string `json:"Body"`
+			} `json:"Content"`
+		} `json:"items"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&messages); err != nil {
+		return "", fmt.Errorf("failed to parse MailHog messages: %w", err)
+	}
+
+	for _, msg := range messages.Items {
+		body := msg.Content.Body
+		if decoded, err := io.ReadAll(quotedprintable.NewReader(strings.NewReader(body))); err == nil {
+			body = string(decoded)
+		}
+		if m := verifyEmailTokenRe.FindStringSubmatch(body); m != nil {
+			return m[1], nil
+		}
+	}
+	return "", nil
+}
+
+// postJSON posts body as JSON and fails unless the response is 2xx.
+func postJSON(httpClient *http.Client, target string, body interface{}) (*http.Response, error) {
+	payload, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := httpClient.Post(target, "application/json", bytes.NewReader(payload))
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		msg, _ := io.ReadAll(io.LimitReader(resp.Body, 1024))
+		return nil, fmt.Errorf("%s returned status %d: %s", target, resp.StatusCode, bytes.TrimSpace(msg))
+	}
+	return resp, nil
+}
+
+func envOrDefault(name, fallback string) string {
+	if v := os.Getenv(name); v != "" {
+		return v
+	}
+	return fallback
+}
@@ -0,0 +1,95 @@
+// Copyright 2025 InferaDB
+// SPDX-License-Identifier: Apache-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+)
+
+// Operations that can be given a timeout in a resource's timeouts block.
+const (
+	opCreate = "create"
+	opRead   = "read"
+	opUpdate = "update"
+	opDelete = "delete"
+)
+
+// resourceTimeouts are the default durations of a resource's operations.
+type resourceTimeouts struct {
+	Create time.Duration
+	Read   time.Duration
+	Update time.Duration
+	Delete time.Duration
+}
+
+// defaultTimeouts suit resources whose operations are single API calls.
+var defaultTimeouts = resourceTimeouts{
+	Create: 2 * time.Minute,
+	Read:   2 * time.Minute,
+	Update: 2 * time.Minute,
+	Delete: 2 * time.Minute,
+}
+
+// timeoutsBlock returns the standard timeouts block, documenting the
+// resource's defaults.
+func timeoutsBlock(ctx context.Context, defaults resourceTimeouts) schema.Block {
+	return timeouts.Block(ctx, timeouts.Opts{
+		Create:            true,
+		Read:              true,
+		Update:            true,
+		Delete:            true,
+		CreateDescription: timeoutDescription(opCreate, defaults.Create),
+		ReadDescription:   timeoutDescription(opRead, defaults.Read),
+		UpdateDescription: timeoutDescription(opUpdate, defaults.Update),
+		DeleteDescription: timeoutDescription(opDelete, defaults.Delete),
+	})
+}
+
+func timeoutDescription(op string, d time.Duration) string {
+	return fmt.Sprintf("Time allowed for the %s operation, including retries and polling, as a duration such as `30s` or `10m`. Defaults to `%s`.", op, formatDuration(d))
+}
+
+// withOperationTimeout returns a context bounded by the configured timeout of
+// op, or by the resource's default for op when none is configured.
+func withOperationTimeout(ctx context.Context, value timeouts.Value, op string, defaults resourceTimeouts) (context.Context, context.CancelFunc, diag.Diagnostics) {
+	var (
+		timeout time.Duration
+		diags   diag.Diagnostics
+	)
+	switch op {
+	case opCreate:
+		timeout, diags = value.Create(ctx, defaults.Create)
+	case opRead:
+		timeout, diags = value.Read(ctx, defaults.Read)
+	case opUpdate:
+		timeout, diags = value.Update(ctx, defaults.Update)
+	default:
+		timeout, diags = value.Delete(ctx, defaults.Delete)
+	}
+	if diags.HasError() {
+		return ctx, func() {}, diags
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	return ctx, cancel, diags
+}
+
+// formatDuration renders d without trailing zero units, e.g. "10m" rather
+// than "10m0s".
+func formatDuration(d time.Duration) string {
+	switch {
+	case d%time.Hour == 0:
+		return fmt.Sprintf("%dh", d/time.Hour)
+	case d%time.Minute == 0:
+		return fmt.Sprintf("%dm", d/time.Minute)
+	default:
+		return d.String()
+	}
+}
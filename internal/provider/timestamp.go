@@ -0,0 +1,126 @@
+// Copyright 2025 InferaDB
+// SPDX-License-Identifier: Apache-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+var (
+	_ basetypes.StringTypable                    = TimestampType{}
+	_ basetypes.StringValuableWithSemanticEquals = TimestampValue{}
+)
+
+// TimestampType is the attribute type of RFC 3339 timestamps such as
+// created_at and granted_at. Values for the same instant are semantically
+// equal, so changes in fractional-second precision or time zone offset
+// returned by the Control API do not show up as diffs.
+type TimestampType struct {
+	basetypes.StringType
+}
+
+// Equal returns true if o is also a TimestampType.
+func (t TimestampType) Equal(o attr.Type) bool {
+	other, ok := o.(TimestampType)
+	if !ok {
+		return false
+	}
+	return t.StringType.Equal(other.StringType)
+}
+
+// String returns a human readable name of the type.
+func (t TimestampType) String() string {
+	return "TimestampType"
+}
+
+// ValueFromString wraps a string value as a TimestampValue.
+func (t TimestampType) ValueFromString(ctx context.Context, in basetypes.StringValue) (basetypes.StringValuable, diag.Diagnostics) {
+	return TimestampValue{StringValue: in}, nil
+}
+
+// ValueFromTerraform converts a Terraform value to a TimestampValue.
+func (t TimestampType) ValueFromTerraform(ctx context.Context, in tftypes.Value) (attr.Value, error) {
+	attrValue, err := t.StringType.ValueFromTerraform(ctx, in)
+	if err != nil {
+		return nil, err
+	}
+
+	stringValue, ok := attrValue.(basetypes.StringValue)
+	if !ok {
+		return nil, fmt.Errorf("unexpected value type of %T", attrValue)
+	}
+
+	stringValuable, diags := t.ValueFromString(ctx, stringValue)
+	if diags.HasError() {
+		return nil, fmt.Errorf("unexpected error converting StringValue to StringValuable: %v", diags)
+	}
+	return stringValuable, nil
+}
+
+// ValueType returns the value type of TimestampType.
+func (t TimestampType) ValueType(ctx context.Context) attr.Value {
+	return TimestampValue{}
+}
+
+// TimestampValue is an RFC 3339 timestamp held as returned by the API.
+type TimestampValue struct {
+	basetypes.StringValue
+}
+
+// NewTimestampValue returns a known TimestampValue.
+func NewTimestampValue(ts string) TimestampValue {
+	return TimestampValue{StringValue: basetypes.NewStringValue(ts)}
+}
+
+// NewTimestampNull returns a null TimestampValue.
+func NewTimestampNull() TimestampValue {
+	return TimestampValue{StringValue: basetypes.NewStringNull()}
+}
+
+// Equal returns true if o is a TimestampValue with the same state and
+// string. Use StringSemanticEquals to compare instants.
+func (v TimestampValue) Equal(o attr.Value) bool {
+	other, ok := o.(TimestampValue)
+	if !ok {
+		return false
+	}
+	return v.StringValue.Equal(other.StringValue)
+}
+
+// Type returns TimestampType.
+func (v TimestampValue) Type(ctx context.Context) attr.Type {
+	return TimestampType{}
+}
+
+// StringSemanticEquals returns true if both values are RFC 3339 timestamps of
+// the same instant. Values that do not parse are compared as strings.
+func (v TimestampValue) StringSemanticEquals(ctx context.Context, newValuable basetypes.StringValuable) (bool, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	newValue, ok := newValuable.(TimestampValue)
+	if !ok {
+		diags.AddError(
+			"Semantic Equality Check Error",
+			fmt.Sprintf("Expected value type %T but got %T. Please report this to the provider developers.", v, newValuable),
+		)
+		return false, diags
+	}
+
+	prior, err := time.Parse(time.RFC3339Nano, v.ValueString())
+	if err != nil {
+		return v.ValueString() == newValue.ValueString(), diags
+	}
+	current, err := time.Parse(time.RFC3339Nano, newValue.ValueString())
+	if err != nil {
+		return false, diags
+	}
+	return prior.Equal(current), diags
+}
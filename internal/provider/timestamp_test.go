@@ -0,0 +1,37 @@
+// Copyright 2025 InferaDB
+// SPDX-License-Identifier: Apache-2.0
+
+package provider
+
+import (
+	"context"
+	"testing"
+)
+
+func TestTimestampValueStringSemanticEquals(t *testing.T) {
+	tests := []struct {
+		name  string
+		prior string
+		next  string
+		want  bool
+	}{
+		{"identical", "2025-01-01T00:00:00Z", "2025-01-01T00:00:00Z", true},
+		{"fractional seconds", "2025-01-01T00:00:00Z", "2025-01-01T00:00:00.000000Z", true},
+		{"offset", "2025-01-01T00:00:00Z", "2025-01-01T01:00:00+01:00", true},
+		{"different instant", "2025-01-01T00:00:00Z", "2025-01-01T00:00:00.5Z", false},
+		{"unparsable prior", "yesterday", "yesterday", true},
+		{"unparsable new", "2025-01-01T00:00:00Z", "yesterday", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, diags := NewTimestampValue(tt.prior).StringSemanticEquals(context.Background(), NewTimestampValue(tt.next))
+			if diags.HasError() {
+				t.Fatalf("unexpected diagnostics: %v", diags)
+			}
+			if got != tt.want {
+				t.Errorf("StringSemanticEquals(%q, %q) = %t, want %t", tt.prior, tt.next, got, tt.want)
+			}
+		})
+	}
+}
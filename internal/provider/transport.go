@@ -0,0 +1,213 @@
+// Copyright 2025 InferaDB
+// SPDX-License-Identifier: Apache-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"net/http"
+	"os"
+	"slices"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/inferadb/terraform-provider-inferadb/internal/client"
+)
+
+// TransportModel describes the provider transport block.
+type TransportModel struct {
+	CACertPEM          types.String `tfsdk:"ca_cert_pem"`
+	CACertFile         types.String `tfsdk:"ca_cert_file"`
+	ClientCertPEM      types.String `tfsdk:"client_cert_pem"`
+	ClientCertFile     types.String `tfsdk:"client_cert_file"`
+	ClientKeyPEM       types.String `tfsdk:"client_key_pem"`
+	ClientKeyFile      types.String `tfsdk:"client_key_file"`
+	InsecureSkipVerify types.Bool   `tfsdk:"insecure_skip_verify"`
+	ProxyURL           types.String `tfsdk:"proxy_url"`
+	RequestTimeout     types.Int64  `tfsdk:"request_timeout"`
+	ExtraHeaders       types.Map    `tfsdk:"extra_headers"`
+}
+
+// transportSettings holds the client settings derived from the transport block.
+type transportSettings struct {
+	transport http.RoundTripper
+	timeout   time.Duration
+	headers   map[string]string
+}
+
+// transportBlock returns the schema of the provider transport block.
+func transportBlock() schema.SingleNestedBlock {
+	return schema.SingleNestedBlock{
+		MarkdownDescription: "Configures connections to the Control API: trusted CAs, mutual TLS, proxy, timeout and additional headers.",
+		Attributes: map[string]schema.Attribute{
+			"ca_cert_pem": schema.StringAttribute{
+				MarkdownDescription: "PEM encoded CA certificates trusted in addition to the system roots, e.g. the internal CA of a private deployment. Conflicts with `ca_cert_file`.",
+				Optional:            true,
+			},
+			"ca_cert_file": schema.StringAttribute{
+				MarkdownDescription: "Path to a PEM encoded CA bundle trusted in addition to the system roots. Can also be set via `INFERADB_CA_CERT_FILE` environment variable.",
+				Optional:            true,
+			},
+			"client_cert_pem": schema.StringAttribute{
+				MarkdownDescription: "PEM encoded certificate presented for mutual TLS. Requires `client_key_pem` or `client_key_file`.",
+				Optional:            true,
+			},
+			"client_cert_file": schema.StringAttribute{
+				MarkdownDescription: "Path to the PEM encoded certificate presented for mutual TLS.",
+				Optional:            true,
+			},
+			"client_key_pem": schema.StringAttribute{
+				MarkdownDescription: "PEM encoded private key of the mutual TLS client certificate.",
+				Optional:            true,
+				Sensitive:           true,
+			},
+			"client_key_file": schema.StringAttribute{
+				MarkdownDescription: "Path to the PEM encoded private key of the mutual TLS client certificate.",
+				Optional:            true,
+			},
+			"insecure_skip_verify": schema.BoolAttribute{
+				MarkdownDescription: "Skip verification of the server certificate. Only use this for local development. Defaults to `false`.",
+				Optional:            true,
+			},
+			"proxy_url": schema.StringAttribute{
+				MarkdownDescription: "URL of the proxy requests are sent through, e.g. `http://proxy.internal:3128`. Defaults to the `HTTPS_PROXY`, `HTTP_PROXY` and `NO_PROXY` environment variables.",
+				Optional:            true,
+			},
+			"request_timeout": schema.Int64Attribute{
+				MarkdownDescription: "Maximum number of seconds a single attempt of a request may take. Defaults to `30`. Within resource operations, attempts also end at the operation's `timeouts` deadline.",
+				Optional:            true,
+			},
+			"extra_headers": schema.MapAttribute{
+				MarkdownDescription: "Additional headers sent with every request, e.g. headers required by a gateway in front of the Control API. `Authorization` and `Cookie` are rejected; configure credentials with the `auth` block. The values are treated as secrets and redacted in logs.",
+				ElementType:         types.StringType,
+				Optional:            true,
+				Sensitive:           true,
+			},
+		},
+	}
+}
+
+// credentialHeaders are the headers extra_headers cannot set, in canonical
+// form: they would be merged with or shadow the credentials of the auth block.
+var credentialHeaders = map[string]bool{
+	"Authorization": true,
+	"Cookie":        true,
+}
+
+// newTransportSettings builds the client transport, timeout and headers from
+// the transport block.
+func newTransportSettings(ctx context.Context, model *TransportModel) (transportSettings, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	settings := transportSettings{timeout: client.DefaultTimeout}
+	if model == nil {
+		model = &TransportModel{}
+	}
+
+	caCert, d := pemValue(model.CACertPEM, model.CACertFile, "ca_cert_pem", "ca_cert_file", "INFERADB_CA_CERT_FILE")
+	diags.Append(d...)
+	clientCert, d := pemValue(model.ClientCertPEM, model.ClientCertFile, "client_cert_pem", "client_cert_file", "")
+	diags.Append(d...)
+	clientKey, d := pemValue(model.ClientKeyPEM, model.ClientKeyFile, "client_key_pem", "client_key_file", "")
+	diags.Append(d...)
+	if diags.HasError() {
+		return settings, diags
+	}
+
+	if !model.RequestTimeout.IsNull() {
+		if model.RequestTimeout.ValueInt64() <= 0 {
+			diags.AddAttributeError(
+				path.Root("transport").AtName("request_timeout"),
+				"Invalid Transport Configuration",
+				"request_timeout must be greater than zero.",
+			)
+			return settings, diags
+		}
+		settings.timeout = time.Duration(model.RequestTimeout.ValueInt64()) * time.Second
+	}
+
+	if !model.ExtraHeaders.IsNull() {
+		diags.Append(model.ExtraHeaders.ElementsAs(ctx, &settings.headers, false)...)
+		if diags.HasError() {
+			return settings, diags
+		}
+		for _, name := range slices.Sorted(maps.Keys(settings.headers)) {
+			if credentialHeaders[http.CanonicalHeaderKey(name)] {
+				diags.AddAttributeError(
+					path.Root("transport").AtName("extra_headers").AtMapKey(name),
+					"Invalid Transport Configuration",
+					fmt.Sprintf("The %s header carries credentials and cannot be set in extra_headers. Use the auth block to configure how the provider authenticates.", name),
+				)
+			}
+		}
+		if diags.HasError() {
+			return settings, diags
+		}
+	}
+
+	// Keep the default transport unless the block changes how connections are made.
+	cfg := client.TransportConfig{
+		CACertPEM:          caCert,
+		ClientCertPEM:      clientCert,
+		ClientKeyPEM:       clientKey,
+		InsecureSkipVerify: model.InsecureSkipVerify.ValueBool(),
+		ProxyURL:           model.ProxyURL.ValueString(),
+	}
+	if cfg == (client.TransportConfig{}) {
+		return settings, diags
+	}
+
+	transport, err := client.NewTransport(cfg)
+	if err != nil {
+		diags.AddAttributeError(
+			path.Root("transport"),
+			"Invalid Transport Configuration",
+			fmt.Sprintf("Could not configure the connection to the Control API: %s", err),
+		)
+		return settings, diags
+	}
+	settings.transport = transport
+	return settings, diags
+}
+
+// pemValue returns PEM content given inline or read from a file. envFile, when
+// not empty, names an environment variable used as the file path fallback.
+func pemValue(inline, file types.String, inlineAttr, fileAttr, envFile string) (string, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	if !inline.IsNull() && !file.IsNull() {
+		diags.AddAttributeError(
+			path.Root("transport").AtName(fileAttr),
+			"Conflicting Transport Configuration",
+			fmt.Sprintf("Only one of %s and %s can be set.", inlineAttr, fileAttr),
+		)
+		return "", diags
+	}
+	if !inline.IsNull() {
+		return inline.ValueString(), diags
+	}
+
+	filename := file.ValueString()
+	if file.IsNull() && envFile != "" {
+		filename = os.Getenv(envFile)
+	}
+	if filename == "" {
+		return "", diags
+	}
+
+	data, err := os.ReadFile(filename)
+	if err != nil {
+		diags.AddAttributeError(
+			path.Root("transport").AtName(fileAttr),
+			"Unable to Read File",
+			fmt.Sprintf("Could not read %s: %s", filename, err),
+		)
+		return "", diags
+	}
+	return string(data), diags
+}
@@ -0,0 +1,43 @@
+// Copyright 2025 InferaDB
+// SPDX-License-Identifier: Apache-2.0
+
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestTransportSettingsRejectCredentialHeaders(t *testing.T) {
+	tests := []struct {
+		name    string
+		header  string
+		wantErr bool
+	}{
+		{"gateway header", "X-Tenant", false},
+		{"authorization", "Authorization", true},
+		{"lower case authorization", "authorization", true},
+		{"cookie", "Cookie", true},
+		{"lower case cookie", "cookie", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			model := &TransportModel{
+				ExtraHeaders: types.MapValueMust(types.StringType, map[string]attr.Value{
+					tt.header: types.StringValue("value"),
+				}),
+			}
+			settings, diags := newTransportSettings(context.Background(), model)
+			if diags.HasError() != tt.wantErr {
+				t.Fatalf("newTransportSettings diagnostics = %v, want error %t", diags, tt.wantErr)
+			}
+			if !tt.wantErr && settings.headers[tt.header] != "value" {
+				t.Errorf("headers = %v, want %s set", settings.headers, tt.header)
+			}
+		})
+	}
+}
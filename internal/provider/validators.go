@@ -0,0 +1,11 @@
+// Copyright 2025 InferaDB
+// SPDX-License-Identifier: Apache-2.0
+
+package provider
+
+// Values accepted by the Control API for enum attributes.
+var (
+	organizationTiers = []string{"dev", "pro", "max"}
+	teamMemberRoles   = []string{"maintainer", "member"}
+	vaultGrantRoles   = []string{"reader", "writer", "manager", "admin"}
+)
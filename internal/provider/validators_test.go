@@ -0,0 +1,65 @@
+// Copyright 2025 InferaDB
+// SPDX-License-Identifier: Apache-2.0
+
+package provider
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestSchemaValidators(t *testing.T) {
+	testFakeControl(t)
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+resource "inferadb_organization" "test" {
+  name = "tf-test-validators"
+  tier = "enterprise"
+}
+`,
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`(?s)value must be one of:.*dev.*pro.*max.*got: "enterprise"`),
+			},
+			{
+				Config: `
+resource "inferadb_team_member" "test" {
+  organization_id = "1"
+  team_id         = "2"
+  user_id         = "3"
+  role            = "owner"
+}
+`,
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`(?s)value must be one of:.*maintainer.*member.*got: "owner"`),
+			},
+			{
+				Config: `
+resource "inferadb_vault_team_grant" "test" {
+  organization_id = "1"
+  vault_id        = "2"
+  team_id         = "3"
+  role            = "superuser"
+}
+`,
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`(?s)value must be one of:.*reader.*writer.*manager.*admin.*got: "superuser"`),
+			},
+			{
+				Config: `
+resource "inferadb_vault" "test" {
+  organization_id = "1"
+  name            = ""
+}
+`,
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`string length must be at least 1`),
+			},
+		},
+	})
+}